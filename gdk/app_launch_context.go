@@ -0,0 +1,83 @@
+package gdk
+
+// #cgo pkg-config: gdk-3.0 gio-2.0
+// #include <gdk/gdk.h>
+// #include "gdk.go.h"
+import "C"
+import (
+	"unsafe"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+/*
+ * GdkAppLaunchContext
+ */
+
+// AppLaunchContext is a representation of GDK's GdkAppLaunchContext.
+// It may be passed to the launch methods of glib.AppInfo, so launched
+// applications start on the right screen and workspace, with startup
+// notification.
+type AppLaunchContext struct {
+	glib.AppLaunchContext
+}
+
+// native returns a pointer to the underlying GdkAppLaunchContext.
+func (v *AppLaunchContext) native() *C.GdkAppLaunchContext {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGdkAppLaunchContext(ptr)
+}
+
+func marshalAppLaunchContext(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := glib.Take(unsafe.Pointer(c))
+	return wrapAppLaunchContext(obj), nil
+}
+
+func wrapAppLaunchContext(obj *glib.Object) *AppLaunchContext {
+	return &AppLaunchContext{glib.AppLaunchContext{Object: obj}}
+}
+
+// ToGlib returns the underlying glib.AppLaunchContext, as taken by
+// the launch methods of glib.AppInfo.
+func (v *AppLaunchContext) ToGlib() *glib.AppLaunchContext {
+	if v == nil {
+		return nil
+	}
+	return &v.AppLaunchContext
+}
+
+// SetScreen is a wrapper around gdk_app_launch_context_set_screen().
+func (v *AppLaunchContext) SetScreen(screen *Screen) {
+	C.gdk_app_launch_context_set_screen(v.native(), screen.native())
+}
+
+// SetDesktop is a wrapper around gdk_app_launch_context_set_desktop().
+// A desktop of -1 launches applications on the current workspace.
+func (v *AppLaunchContext) SetDesktop(desktop int) {
+	C.gdk_app_launch_context_set_desktop(v.native(), C.gint(desktop))
+}
+
+// SetTimestamp is a wrapper around gdk_app_launch_context_set_timestamp().
+func (v *AppLaunchContext) SetTimestamp(timestamp uint32) {
+	C.gdk_app_launch_context_set_timestamp(v.native(), C.guint32(timestamp))
+}
+
+// SetIcon is a wrapper around gdk_app_launch_context_set_icon().
+func (v *AppLaunchContext) SetIcon(icon *glib.Icon) {
+	var cicon *C.GIcon
+	if icon != nil {
+		cicon = (*C.GIcon)(unsafe.Pointer(icon.Native()))
+	}
+	C.gdk_app_launch_context_set_icon(v.native(), cicon)
+}
+
+// SetIconName is a wrapper around gdk_app_launch_context_set_icon_name().
+func (v *AppLaunchContext) SetIconName(iconName string) {
+	cstr := C.CString(iconName)
+	defer C.free(unsafe.Pointer(cstr))
+	C.gdk_app_launch_context_set_icon_name(v.native(), (*C.char)(cstr))
+}
@@ -51,6 +51,7 @@ func init() {
 		{glib.Type(C.gdk_pixbuf_alpha_mode_get_type()), marshalPixbufAlphaMode},
 
 		// Objects/Interfaces
+		{glib.Type(C.gdk_app_launch_context_get_type()), marshalAppLaunchContext},
 		{glib.Type(C.gdk_device_get_type()), marshalDevice},
 		{glib.Type(C.gdk_cursor_get_type()), marshalCursor},
 		{glib.Type(C.gdk_device_manager_get_type()), marshalDeviceManager},
@@ -714,9 +715,17 @@ func (v *Display) SupportsInputShapes() bool {
 	return gobool(c)
 }
 
-// TODO(jrick) glib.AppLaunchContext GdkAppLaunchContext
-func (v *Display) GetAppLaunchContext() {
-	panic("Not implemented")
+// GetAppLaunchContext is a wrapper around gdk_display_get_app_launch_context().
+func (v *Display) GetAppLaunchContext() (*AppLaunchContext, error) {
+	c := C.gdk_display_get_app_launch_context(v.native())
+	if c == nil {
+		return nil, nilPtrErr
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	// The context is returned with a full reference,
+	// which is released once it is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapAppLaunchContext(obj), nil
 }
 
 // NotifyStartupComplete is a wrapper around gdk_display_notify_startup_complete().
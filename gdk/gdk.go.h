@@ -56,6 +56,12 @@ toGdkDeviceManager(void *p)
 	return (GDK_DEVICE_MANAGER(p));
 }
 
+static GdkAppLaunchContext *
+toGdkAppLaunchContext(void *p)
+{
+	return (GDK_APP_LAUNCH_CONTEXT(p));
+}
+
 static GdkDisplay *
 toGdkDisplay(void *p)
 {
@@ -6,7 +6,6 @@ package gdk
 import "C"
 
 import (
-	"errors"
 	"reflect"
 	"runtime"
 	"strconv"
@@ -28,6 +27,36 @@ func marshalPixbufAlphaMode(p uintptr) (interface{}, error) {
 	return PixbufAlphaMode(c), nil
 }
 
+// PixbufErrorQuark is a wrapper around gdk_pixbuf_error_quark().
+func PixbufErrorQuark() glib.Quark {
+	return glib.Quark(C.gdk_pixbuf_error_quark())
+}
+
+// PixbufError is a representation of GDK's GdkPixbufError, the codes of
+// the errors of the PixbufErrorQuark domain.
+type PixbufError int
+
+const (
+	PIXBUF_ERROR_CORRUPT_IMAGE         PixbufError = C.GDK_PIXBUF_ERROR_CORRUPT_IMAGE
+	PIXBUF_ERROR_INSUFFICIENT_MEMORY   PixbufError = C.GDK_PIXBUF_ERROR_INSUFFICIENT_MEMORY
+	PIXBUF_ERROR_BAD_OPTION            PixbufError = C.GDK_PIXBUF_ERROR_BAD_OPTION
+	PIXBUF_ERROR_UNKNOWN_TYPE          PixbufError = C.GDK_PIXBUF_ERROR_UNKNOWN_TYPE
+	PIXBUF_ERROR_UNSUPPORTED_OPERATION PixbufError = C.GDK_PIXBUF_ERROR_UNSUPPORTED_OPERATION
+	PIXBUF_ERROR_FAILED                PixbufError = C.GDK_PIXBUF_ERROR_FAILED
+	PIXBUF_ERROR_INCOMPLETE_ANIMATION  PixbufError = C.GDK_PIXBUF_ERROR_INCOMPLETE_ANIMATION
+)
+
+// Errors of the PixbufErrorQuark domain, to be matched with errors.Is.
+var (
+	ErrPixbufCorruptImage         = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_CORRUPT_IMAGE), "corrupt image")
+	ErrPixbufInsufficientMemory   = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_INSUFFICIENT_MEMORY), "insufficient memory")
+	ErrPixbufBadOption            = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_BAD_OPTION), "bad option")
+	ErrPixbufUnknownType          = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_UNKNOWN_TYPE), "unknown image type")
+	ErrPixbufUnsupportedOperation = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_UNSUPPORTED_OPERATION), "unsupported operation")
+	ErrPixbufFailed               = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_FAILED), "operation failed")
+	ErrPixbufIncompleteAnimation  = glib.NewError(PixbufErrorQuark(), int(PIXBUF_ERROR_INCOMPLETE_ANIMATION), "incomplete animation")
+)
+
 /*
  * GdkPixbufFormat
  */
@@ -217,8 +246,7 @@ func PixbufNewFromFile(filename string) (*Pixbuf, error) {
 	var err *C.GError
 	res := C.gdk_pixbuf_new_from_file(cstr, &err)
 	if res == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return &Pixbuf{glib.Take(unsafe.Pointer(res))}, nil
@@ -234,8 +262,7 @@ func PixbufNewFromStream(stream *glib.InputStream, cancellable *glib.Cancellable
 	res := C.gdk_pixbuf_new_from_stream(C.toGInputStream(unsafe.Pointer(stream.Native())),
 		cancell, &err)
 	if res == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return &Pixbuf{glib.Take(unsafe.Pointer(res))}, nil
@@ -249,8 +276,7 @@ func PixbufNewFromFileAtSize(filename string, width, height int) (*Pixbuf, error
 	var err *C.GError
 	res := C.gdk_pixbuf_new_from_file_at_size(cstr, C.int(width), C.int(height), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if res == nil {
@@ -269,8 +295,7 @@ func PixbufNewFromFileAtScale(filename string, width, height int, preserveAspect
 	res := C.gdk_pixbuf_new_from_file_at_scale(cstr, C.int(width), C.int(height),
 		gbool(preserveAspectRatio), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if res == nil {
@@ -332,8 +357,7 @@ func (v *Pixbuf) SaveJPEG(path string, quality int) error {
 	var err *C.GError
 	c := C._gdk_pixbuf_save_jpeg(v.native(), cpath, &err, cquality)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -350,8 +374,7 @@ func (v *Pixbuf) SavePNG(path string, compression int) error {
 	var err *C.GError
 	c := C._gdk_pixbuf_save_png(v.native(), cpath, &err, ccompression)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -403,8 +426,7 @@ func PixbufAnimationNewFromStream(stream *glib.InputStream, cancellable *glib.Ca
 	res := C.gdk_pixbuf_animation_new_from_stream(C.toGInputStream(unsafe.Pointer(stream.Native())),
 		cancell, &err)
 	if res == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return &PixbufAnimation{glib.Take(unsafe.Pointer(res))}, nil
@@ -449,8 +471,7 @@ func PixbufLoaderNewWithType(t string) (*PixbufLoader, error) {
 
 	c := C.gdk_pixbuf_loader_new_with_type(cstr, &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	if c == nil {
@@ -479,8 +500,7 @@ func (v *PixbufLoader) Write(data []byte) (int, error) {
 		&err)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return 0, errors.New(goString(err.message))
+		return 0, glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return len(data), nil
@@ -494,8 +514,7 @@ func (v *PixbufLoader) WriteBytes(bytes *glib.Bytes) error {
 		&err)
 
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -508,8 +527,7 @@ func (v *PixbufLoader) Close() error {
 	var err *C.GError
 
 	if ok := gobool(C.gdk_pixbuf_loader_close(v.native(), &err)); !ok {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -8,8 +8,7 @@ package glib
 // #include "action.go.h"
 import "C"
 import (
-	"errors"
-
+	"runtime"
 	"unsafe"
 )
 
@@ -48,6 +47,16 @@ func wrapAction(intf Interface) *Action {
 	return &Action{intf}
 }
 
+// takeAction wraps an action returned with a full reference, which is
+// released when the returned Action is collected, like takeIcon does.
+func takeAction(c *C.GAction) *Action {
+	action := wrapAction(*InterfaceNew(unsafe.Pointer(c)))
+	runtime.SetFinalizer(action, func(v *Action) {
+		C.g_object_unref(C.gpointer(v.native()))
+	})
+	return action
+}
+
 // gboolean
 // g_action_name_is_valid (const gchar *action_name);
 func ActionNameIsValid(actionName string) bool {
@@ -149,8 +158,7 @@ func ParseDetailedName(detailedName string) (actionName string, targetValue *Var
 	var err *C.GError
 	c := C.g_action_parse_detailed_name((*C.gchar)(cstr), &an, &tv, &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return "", nil, errors.New(goString(err.message))
+		return "", nil, takeError(err)
 	}
 
 	defer C.g_free(C.gpointer(an))
@@ -25,4 +25,22 @@ toGActionMap(void *p)
 	return (G_ACTION_MAP(p));
 }
 
+static GActionGroup *
+toGActionGroup(void *p)
+{
+	return (G_ACTION_GROUP(p));
+}
+
+static GSimpleActionGroup *
+toGSimpleActionGroup(void *p)
+{
+	return (G_SIMPLE_ACTION_GROUP(p));
+}
+
+static GPropertyAction *
+toGPropertyAction(void *p)
+{
+	return (G_PROPERTY_ACTION(p));
+}
+
 #endif
@@ -0,0 +1,328 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "action.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+/*
+ * GActionGroup
+ */
+
+// IActionGroup is an interface type implemented by all structs
+// embedding an ActionGroup.
+type IActionGroup interface {
+	toActionGroup() *C.GActionGroup
+	// Use this method to expose access to GLIB underlying object
+	// in external packages.
+	Native() uintptr
+}
+
+// ActionGroup is a representation of GActionGroup GInterface, a group of
+// actions, which widgets refer to by their name prefixed by the name the
+// group was inserted with, like "row.delete".
+type ActionGroup struct {
+	Interface
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var _ IActionGroup = &ActionGroup{}
+
+// native() returns a pointer to the underlying GActionGroup.
+func (v *ActionGroup) native() *C.GActionGroup {
+	return C.toGActionGroup(unsafe.Pointer(v.Native()))
+}
+
+func (v *ActionGroup) toActionGroup() *C.GActionGroup {
+	return v.native()
+}
+
+func marshalActionGroup(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapActionGroup(*InterfaceFromObjectNew(obj)), nil
+}
+
+func wrapActionGroup(intf Interface) *ActionGroup {
+	return &ActionGroup{intf}
+}
+
+// ToActionGroup returns the ActionGroup interface of obj, which must
+// implement it, like applications and application windows do.
+func ToActionGroup(obj *Object) *ActionGroup {
+	return wrapActionGroup(*InterfaceFromObjectNew(obj))
+}
+
+// HasAction is a wrapper around g_action_group_has_action().
+func (v *ActionGroup) HasAction(actionName string) bool {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_action_group_has_action(v.native(), (*C.gchar)(cstr))
+	return gobool(c)
+}
+
+// ListActions is a wrapper around g_action_group_list_actions().
+func (v *ActionGroup) ListActions() []string {
+	c := C.g_action_group_list_actions(v.native())
+	if c == nil {
+		return nil
+	}
+	defer C.g_strfreev(c)
+
+	return goStringArray(c)
+}
+
+// GetActionEnabled is a wrapper around g_action_group_get_action_enabled().
+func (v *ActionGroup) GetActionEnabled(actionName string) bool {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_action_group_get_action_enabled(v.native(), (*C.gchar)(cstr))
+	return gobool(c)
+}
+
+// GetActionParameterType is a wrapper around
+// g_action_group_get_action_parameter_type().
+func (v *ActionGroup) GetActionParameterType(actionName string) *VariantType {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_action_group_get_action_parameter_type(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	return copyVariantType(c)
+}
+
+// GetActionStateType is a wrapper around
+// g_action_group_get_action_state_type().
+func (v *ActionGroup) GetActionStateType(actionName string) *VariantType {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_action_group_get_action_state_type(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	return copyVariantType(c)
+}
+
+// takeVariant wraps a GVariant returned with a full reference.
+func takeVariant(c *C.GVariant) *Variant {
+	if c == nil {
+		return nil
+	}
+	v := WrapVariant(unsafe.Pointer(c))
+	// WrapVariant() takes its own reference.
+	C.g_variant_unref(c)
+	return v
+}
+
+// GetActionStateHint is a wrapper around
+// g_action_group_get_action_state_hint().
+func (v *ActionGroup) GetActionStateHint(actionName string) *Variant {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return takeVariant(C.g_action_group_get_action_state_hint(v.native(), (*C.gchar)(cstr)))
+}
+
+// GetActionState is a wrapper around g_action_group_get_action_state().
+// It returns nil for stateless actions.
+func (v *ActionGroup) GetActionState(actionName string) *Variant {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return takeVariant(C.g_action_group_get_action_state(v.native(), (*C.gchar)(cstr)))
+}
+
+// ChangeActionState is a wrapper around g_action_group_change_action_state().
+func (v *ActionGroup) ChangeActionState(actionName string, value *Variant) {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_action_group_change_action_state(v.native(), (*C.gchar)(cstr), value.native())
+}
+
+// ActivateAction is a wrapper around g_action_group_activate_action().
+// parameter must be nil for actions without parameter.
+func (v *ActionGroup) ActivateAction(actionName string, parameter *Variant) {
+	cstr := C.CString(actionName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_action_group_activate_action(v.native(), (*C.gchar)(cstr), parameter.native())
+}
+
+// object returns the instance implementing the group, to connect to its
+// signals.
+func (v *ActionGroup) object() *Object {
+	return wrapObject(unsafe.Pointer(v.Native()))
+}
+
+// ConnectActionAdded connects f to the "action-added" signal of the
+// group, emitted once an action is added.
+func (v *ActionGroup) ConnectActionAdded(f func(actionName string)) (SignalHandle, error) {
+	return v.object().Connect("action-added", func(_ interface{}, actionName string) {
+		f(actionName)
+	})
+}
+
+// ConnectActionRemoved connects f to the "action-removed" signal of the
+// group, emitted before an action is removed.
+func (v *ActionGroup) ConnectActionRemoved(f func(actionName string)) (SignalHandle, error) {
+	return v.object().Connect("action-removed", func(_ interface{}, actionName string) {
+		f(actionName)
+	})
+}
+
+// ConnectActionEnabledChanged connects f to the "action-enabled-changed"
+// signal of the group, emitted once an action is enabled or disabled.
+func (v *ActionGroup) ConnectActionEnabledChanged(f func(actionName string,
+	enabled bool)) (SignalHandle, error) {
+	return v.object().Connect("action-enabled-changed", func(_ interface{},
+		actionName string, enabled bool) {
+		f(actionName, enabled)
+	})
+}
+
+// ConnectActionStateChanged connects f to the "action-state-changed"
+// signal of the group, emitted once the state of an action changes.
+func (v *ActionGroup) ConnectActionStateChanged(f func(actionName string,
+	value *Variant)) (SignalHandle, error) {
+	return v.object().Connect("action-state-changed", func(_ interface{},
+		actionName string, value *Variant) {
+		if value.native() == nil {
+			f(actionName, nil)
+			return
+		}
+		// The value only lives as long as the emission.
+		f(actionName, WrapVariant(unsafe.Pointer(value.native())))
+	})
+}
+
+/*
+ * GSimpleActionGroup
+ */
+
+// SimpleActionGroup is a representation of GSimpleActionGroup, a group
+// holding the actions added to it, to be inserted into widgets with
+// gtk.Widget.InsertActionGroup.
+type SimpleActionGroup struct {
+	*Object
+	// Interfaces
+	ActionGroup
+	ActionMap
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var _ IActionGroup = &SimpleActionGroup{}
+
+// native() returns a pointer to the underlying GSimpleActionGroup.
+func (v *SimpleActionGroup) native() *C.GSimpleActionGroup {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSimpleActionGroup(ptr)
+}
+
+func marshalSimpleActionGroup(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSimpleActionGroup(obj), nil
+}
+
+func wrapSimpleActionGroup(obj *Object) *SimpleActionGroup {
+	group := wrapActionGroup(*InterfaceFromObjectNew(obj))
+	actionMap := wrapActionMap(*InterfaceFromObjectNew(obj))
+	return &SimpleActionGroup{obj, *group, *actionMap}
+}
+
+// SimpleActionGroupNew is a wrapper around g_simple_action_group_new().
+func SimpleActionGroupNew() (*SimpleActionGroup, error) {
+	c := C.g_simple_action_group_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSimpleActionGroup(obj), nil
+}
+
+/*
+ * GPropertyAction
+ */
+
+// PropertyAction is a representation of GPropertyAction, an action
+// whose state is the value of a property of an object. Activating it
+// toggles boolean properties, and sets the others to its parameter.
+type PropertyAction struct {
+	*Object
+	// Interfaces
+	Action
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var _ IAction = &PropertyAction{}
+
+// native() returns a pointer to the underlying GPropertyAction.
+func (v *PropertyAction) native() *C.GPropertyAction {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGPropertyAction(ptr)
+}
+
+func marshalPropertyAction(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapPropertyAction(obj), nil
+}
+
+func wrapPropertyAction(obj *Object) *PropertyAction {
+	action := wrapAction(*InterfaceFromObjectNew(obj))
+	return &PropertyAction{obj, *action}
+}
+
+// PropertyActionNew is a wrapper around g_property_action_new(). The
+// action named name reflects the property propertyName of object, such
+// as the "visible" property of a widget.
+func PropertyActionNew(name string, object IObject, propertyName string) (*PropertyAction, error) {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	cprop := C.CString(propertyName)
+	defer C.free(unsafe.Pointer(cprop))
+
+	c := C.g_property_action_new((*C.gchar)(cname),
+		C.gpointer(unsafe.Pointer(object.toObject().Native())), (*C.gchar)(cprop))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapPropertyAction(obj), nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_action_group_get_type()), marshalActionGroup},
+		{Type(C.g_simple_action_group_get_type()), marshalSimpleActionGroup},
+		{Type(C.g_property_action_get_type()), marshalPropertyAction},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,92 @@
+package glib_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestSimpleActionGroup(t *testing.T) {
+	group, err := glib.SimpleActionGroupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var added []string
+	group.ConnectActionAdded(func(actionName string) {
+		added = append(added, actionName)
+	})
+	var states []bool
+	group.ConnectActionStateChanged(func(actionName string, value *glib.Variant) {
+		states = append(states, value.GetBoolean())
+	})
+
+	state, err := glib.VariantBooleanNew(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pinned, err := glib.SimpleActionStatefullNew("pinned", nil, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	group.AddAction(pinned)
+
+	// The "enabled" property of the action is exposed as an action.
+	property, err := glib.PropertyActionNew("pinned-enabled", pinned, "enabled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	group.AddAction(property)
+
+	if len(added) != 2 || added[0] != "pinned" || added[1] != "pinned-enabled" {
+		t.Errorf("Expected two added actions, got %v", added)
+	}
+	if !group.HasAction("pinned") || group.HasAction("delete") {
+		t.Error("Expected only the added actions to be in the group")
+	}
+	if actions := group.ListActions(); len(actions) != 2 {
+		t.Errorf("Expected two actions, got %v", actions)
+	}
+
+	state, err = glib.VariantBooleanNew(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	group.ChangeActionState("pinned", state)
+	if len(states) != 1 || !states[0] {
+		t.Errorf("Expected a single change of state to true, got %v", states)
+	}
+	if !group.GetActionState("pinned").GetBoolean() {
+		t.Error("Expected the state to be true")
+	}
+
+	group.ActivateAction("pinned-enabled", nil)
+	if pinned.GetEnabled() || group.GetActionEnabled("pinned") {
+		t.Error("Expected the action to be disabled through its property")
+	}
+}
+
+func TestActionGroupTypesOutliveActions(t *testing.T) {
+	group, err := glib.SimpleActionGroupNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	func() {
+		open, err := glib.SimpleActionNew("open", glib.VARIANT_TYPE_STRING)
+		if err != nil {
+			t.Fatal(err)
+		}
+		group.AddAction(open)
+	}()
+
+	parameterType := group.GetActionParameterType("open")
+	if group.GetActionStateType("open") != nil {
+		t.Error("Expected a stateless action")
+	}
+	group.RemoveAction("open")
+	runtime.GC()
+	runtime.GC()
+	if s := parameterType.String(); s != "s" {
+		t.Errorf("Expected the parameter type %q, got %q", "s", s)
+	}
+}
@@ -0,0 +1,639 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+// AppInfoCreateFlags is a representation of GLib's GAppInfoCreateFlags.
+type AppInfoCreateFlags int
+
+const (
+	APP_INFO_CREATE_NONE                          AppInfoCreateFlags = C.G_APP_INFO_CREATE_NONE
+	APP_INFO_CREATE_NEEDS_TERMINAL                AppInfoCreateFlags = C.G_APP_INFO_CREATE_NEEDS_TERMINAL
+	APP_INFO_CREATE_SUPPORTS_URIS                 AppInfoCreateFlags = C.G_APP_INFO_CREATE_SUPPORTS_URIS
+	APP_INFO_CREATE_SUPPORTS_STARTUP_NOTIFICATION AppInfoCreateFlags = C.G_APP_INFO_CREATE_SUPPORTS_STARTUP_NOTIFICATION
+)
+
+/*
+ * GAppInfo
+ */
+
+// AppInfo is a representation of GAppInfo GInterface.
+type AppInfo struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GAppInfo.
+func (v *AppInfo) native() *C.GAppInfo {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGAppInfo(ptr)
+}
+
+func marshalAppInfo(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapAppInfo(obj), nil
+}
+
+func wrapAppInfo(obj *Object) *AppInfo {
+	return &AppInfo{obj}
+}
+
+// WrapAppInfo wraps a GAppInfo pointer, for use by other packages
+// of gotk3 which return application informations.
+func WrapAppInfo(ptr uintptr) *AppInfo {
+	if ptr == 0 {
+		return nil
+	}
+	return wrapAppInfo(Take(unsafe.Pointer(ptr)))
+}
+
+// appInfoFull wraps a GAppInfo returned with a full reference.
+func appInfoFull(c *C.GAppInfo) *AppInfo {
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapAppInfo(obj)
+}
+
+// appInfoList converts a GList of GAppInfo owned by the caller,
+// and frees it.
+func appInfoList(c *C.GList) []*AppInfo {
+	// Both the list and its items are owned by the caller.
+	defer C.g_list_free_full(c, C.GDestroyNotify(C.g_object_unref))
+
+	var infos []*AppInfo
+	for l := c; l != nil; l = l.next {
+		obj := Take(unsafe.Pointer(l.data))
+		infos = append(infos, wrapAppInfo(obj))
+	}
+	return infos
+}
+
+// AppInfoCreateFromCommandline is a wrapper around g_app_info_create_from_commandline().
+func AppInfoCreateFromCommandline(commandline, applicationName string,
+	flags AppInfoCreateFlags) (*AppInfo, error) {
+	cstr1 := C.CString(commandline)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	var cstr2 *C.char
+	if applicationName != "" {
+		cstr2 = C.CString(applicationName)
+		defer C.free(unsafe.Pointer(cstr2))
+	}
+
+	var err *C.GError
+	c := C.g_app_info_create_from_commandline(cstr1, cstr2, C.GAppInfoCreateFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return appInfoFull(c), nil
+}
+
+// AppInfoGetAll is a wrapper around g_app_info_get_all().
+func AppInfoGetAll() []*AppInfo {
+	return appInfoList(C.g_app_info_get_all())
+}
+
+// AppInfoGetAllForType is a wrapper around g_app_info_get_all_for_type().
+func AppInfoGetAllForType(contentType string) []*AppInfo {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return appInfoList(C.g_app_info_get_all_for_type(cstr))
+}
+
+// AppInfoGetRecommendedForType is a wrapper around g_app_info_get_recommended_for_type().
+func AppInfoGetRecommendedForType(contentType string) []*AppInfo {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return appInfoList(C.g_app_info_get_recommended_for_type(cstr))
+}
+
+// AppInfoGetFallbackForType is a wrapper around g_app_info_get_fallback_for_type().
+func AppInfoGetFallbackForType(contentType string) []*AppInfo {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return appInfoList(C.g_app_info_get_fallback_for_type(cstr))
+}
+
+// AppInfoGetDefaultForType is a wrapper around g_app_info_get_default_for_type().
+// nil is returned if there is no default application for contentType.
+func AppInfoGetDefaultForType(contentType string, mustSupportURIs bool) *AppInfo {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_app_info_get_default_for_type(cstr, gbool(mustSupportURIs))
+	if c == nil {
+		return nil
+	}
+	return appInfoFull(c)
+}
+
+// AppInfoGetDefaultForURIScheme is a wrapper around g_app_info_get_default_for_uri_scheme().
+// nil is returned if there is no default application for uriScheme.
+func AppInfoGetDefaultForURIScheme(uriScheme string) *AppInfo {
+	cstr := C.CString(uriScheme)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_app_info_get_default_for_uri_scheme(cstr)
+	if c == nil {
+		return nil
+	}
+	return appInfoFull(c)
+}
+
+// AppInfoResetTypeAssociations is a wrapper around g_app_info_reset_type_associations().
+func AppInfoResetTypeAssociations(contentType string) {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_app_info_reset_type_associations(cstr)
+}
+
+// AppInfoLaunchDefaultForURI is a wrapper around g_app_info_launch_default_for_uri().
+// context may be nil.
+func AppInfoLaunchDefaultForURI(uri string, context *AppLaunchContext) error {
+	cstr := C.CString(uri)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_app_info_launch_default_for_uri(cstr, context.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// Dup is a wrapper around g_app_info_dup().
+func (v *AppInfo) Dup() *AppInfo {
+	return appInfoFull(C.g_app_info_dup(v.native()))
+}
+
+// Equal is a wrapper around g_app_info_equal().
+func (v *AppInfo) Equal(other *AppInfo) bool {
+	return gobool(C.g_app_info_equal(v.native(), other.native()))
+}
+
+// GetID is a wrapper around g_app_info_get_id().
+func (v *AppInfo) GetID() string {
+	c := C.g_app_info_get_id(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetName is a wrapper around g_app_info_get_name().
+func (v *AppInfo) GetName() string {
+	return C.GoString(C.g_app_info_get_name(v.native()))
+}
+
+// GetDisplayName is a wrapper around g_app_info_get_display_name().
+func (v *AppInfo) GetDisplayName() string {
+	return C.GoString(C.g_app_info_get_display_name(v.native()))
+}
+
+// GetDescription is a wrapper around g_app_info_get_description().
+func (v *AppInfo) GetDescription() string {
+	c := C.g_app_info_get_description(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetExecutable is a wrapper around g_app_info_get_executable().
+func (v *AppInfo) GetExecutable() string {
+	return C.GoString(C.g_app_info_get_executable(v.native()))
+}
+
+// GetCommandline is a wrapper around g_app_info_get_commandline().
+func (v *AppInfo) GetCommandline() string {
+	c := C.g_app_info_get_commandline(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetIcon is a wrapper around g_app_info_get_icon().
+func (v *AppInfo) GetIcon() *Icon {
+	c := C.g_app_info_get_icon(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapIcon(*InterfaceFromObjectNew(obj))
+}
+
+// ShouldShow is a wrapper around g_app_info_should_show().
+func (v *AppInfo) ShouldShow() bool {
+	return gobool(C.g_app_info_should_show(v.native()))
+}
+
+// SupportsURIs is a wrapper around g_app_info_supports_uris().
+func (v *AppInfo) SupportsURIs() bool {
+	return gobool(C.g_app_info_supports_uris(v.native()))
+}
+
+// SupportsFiles is a wrapper around g_app_info_supports_files().
+func (v *AppInfo) SupportsFiles() bool {
+	return gobool(C.g_app_info_supports_files(v.native()))
+}
+
+// GetSupportedTypes is a wrapper around g_app_info_get_supported_types().
+func (v *AppInfo) GetSupportedTypes() []string {
+	c := C.g_app_info_get_supported_types(v.native())
+	if c == nil {
+		return nil
+	}
+	// owned by the info, do not free.
+	return goStringArray((**C.gchar)(unsafe.Pointer(c)))
+}
+
+// Launch is a wrapper around g_app_info_launch().
+// files and context may be nil.
+func (v *AppInfo) Launch(files []*File, context *AppLaunchContext) error {
+	var cfiles *C.GList
+	for _, file := range files {
+		cfiles = C.g_list_append(cfiles, C.gpointer(file.native()))
+	}
+	defer C.g_list_free(cfiles)
+
+	var err *C.GError
+	c := C.g_app_info_launch(v.native(), cfiles, context.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// LaunchURIs is a wrapper around g_app_info_launch_uris().
+// uris and context may be nil.
+func (v *AppInfo) LaunchURIs(uris []string, context *AppLaunchContext) error {
+	var curis *C.GList
+	for _, uri := range uris {
+		cstr := C.CString(uri)
+		defer C.free(unsafe.Pointer(cstr))
+		curis = C.g_list_append(curis, C.gpointer(cstr))
+	}
+	defer C.g_list_free(curis)
+
+	var err *C.GError
+	c := C.g_app_info_launch_uris(v.native(), curis, context.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// CanDelete is a wrapper around g_app_info_can_delete().
+func (v *AppInfo) CanDelete() bool {
+	return gobool(C.g_app_info_can_delete(v.native()))
+}
+
+// Delete is a wrapper around g_app_info_delete().
+func (v *AppInfo) Delete() bool {
+	return gobool(C.g_app_info_delete(v.native()))
+}
+
+// SetAsDefaultForType is a wrapper around g_app_info_set_as_default_for_type().
+func (v *AppInfo) SetAsDefaultForType(contentType string) error {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_app_info_set_as_default_for_type(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// SetAsDefaultForExtension is a wrapper around g_app_info_set_as_default_for_extension().
+func (v *AppInfo) SetAsDefaultForExtension(extension string) error {
+	cstr := C.CString(extension)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_app_info_set_as_default_for_extension(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// SetAsLastUsedForType is a wrapper around g_app_info_set_as_last_used_for_type().
+func (v *AppInfo) SetAsLastUsedForType(contentType string) error {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_app_info_set_as_last_used_for_type(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// AddSupportsType is a wrapper around g_app_info_add_supports_type().
+func (v *AppInfo) AddSupportsType(contentType string) error {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_app_info_add_supports_type(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// CanRemoveSupportsType is a wrapper around g_app_info_can_remove_supports_type().
+func (v *AppInfo) CanRemoveSupportsType() bool {
+	return gobool(C.g_app_info_can_remove_supports_type(v.native()))
+}
+
+// RemoveSupportsType is a wrapper around g_app_info_remove_supports_type().
+func (v *AppInfo) RemoveSupportsType(contentType string) error {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_app_info_remove_supports_type(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * GAppLaunchContext
+ */
+
+// AppLaunchContext is a representation of GAppLaunchContext.
+type AppLaunchContext struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GAppLaunchContext.
+func (v *AppLaunchContext) native() *C.GAppLaunchContext {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGAppLaunchContext(ptr)
+}
+
+func marshalAppLaunchContext(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapAppLaunchContext(obj), nil
+}
+
+func wrapAppLaunchContext(obj *Object) *AppLaunchContext {
+	return &AppLaunchContext{obj}
+}
+
+// AppLaunchContextNew is a wrapper around g_app_launch_context_new().
+func AppLaunchContextNew() (*AppLaunchContext, error) {
+	c := C.g_app_launch_context_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapAppLaunchContext(obj), nil
+}
+
+// Setenv is a wrapper around g_app_launch_context_setenv().
+func (v *AppLaunchContext) Setenv(variable, value string) {
+	cstr1 := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_app_launch_context_setenv(v.native(), cstr1, cstr2)
+}
+
+// Unsetenv is a wrapper around g_app_launch_context_unsetenv().
+func (v *AppLaunchContext) Unsetenv(variable string) {
+	cstr := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_app_launch_context_unsetenv(v.native(), cstr)
+}
+
+// GetEnvironment is a wrapper around g_app_launch_context_get_environment().
+func (v *AppLaunchContext) GetEnvironment() []string {
+	c := C.g_app_launch_context_get_environment(v.native())
+	if c == nil {
+		return nil
+	}
+	// both pointer array and strings should be freed.
+	defer C.g_strfreev((**C.gchar)(unsafe.Pointer(c)))
+
+	return goStringArray((**C.gchar)(unsafe.Pointer(c)))
+}
+
+// LaunchFailed is a wrapper around g_app_launch_context_launch_failed().
+func (v *AppLaunchContext) LaunchFailed(startupNotifyID string) {
+	cstr := C.CString(startupNotifyID)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_app_launch_context_launch_failed(v.native(), cstr)
+}
+
+/*
+ * Content types
+ */
+
+// ContentTypeGuess is a wrapper around g_content_type_guess().
+// filename and data may be empty, but at least one of them should be
+// given. Whether the result is uncertain is returned along with it.
+func ContentTypeGuess(filename string, data []byte) (string, bool) {
+	var cstr *C.gchar
+	if filename != "" {
+		cstr = (*C.gchar)(C.CString(filename))
+		defer C.free(unsafe.Pointer(cstr))
+	}
+
+	var cdata *C.guchar
+	if len(data) > 0 {
+		cdata = (*C.guchar)(unsafe.Pointer(&data[0]))
+	}
+
+	var uncertain C.gboolean
+	c := C.g_content_type_guess(cstr, cdata, C.gsize(len(data)), &uncertain)
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c), gobool(uncertain)
+}
+
+// ContentTypeEquals is a wrapper around g_content_type_equals().
+func ContentTypeEquals(type1, type2 string) bool {
+	cstr1 := C.CString(type1)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(type2)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	return gobool(C.g_content_type_equals(cstr1, cstr2))
+}
+
+// ContentTypeIsA is a wrapper around g_content_type_is_a().
+func ContentTypeIsA(contentType, supertype string) bool {
+	cstr1 := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(supertype)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	return gobool(C.g_content_type_is_a(cstr1, cstr2))
+}
+
+// ContentTypeIsUnknown is a wrapper around g_content_type_is_unknown().
+func ContentTypeIsUnknown(contentType string) bool {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_content_type_is_unknown(cstr))
+}
+
+// ContentTypeGetDescription is a wrapper around g_content_type_get_description().
+func ContentTypeGetDescription(contentType string) string {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_content_type_get_description(cstr)
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c)
+}
+
+// ContentTypeGetMimeType is a wrapper around g_content_type_get_mime_type().
+// An empty string is returned if contentType has no known MIME type.
+func ContentTypeGetMimeType(contentType string) string {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_content_type_get_mime_type(cstr)
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c)
+}
+
+// ContentTypeFromMimeType is a wrapper around g_content_type_from_mime_type().
+// An empty string is returned if mimeType is not known.
+func ContentTypeFromMimeType(mimeType string) string {
+	cstr := C.CString(mimeType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_content_type_from_mime_type(cstr)
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c)
+}
+
+// ContentTypeGetIcon is a wrapper around g_content_type_get_icon().
+func ContentTypeGetIcon(contentType string) *Icon {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_content_type_get_icon(cstr)
+	if c == nil {
+		return nil
+	}
+	return takeIcon(c)
+}
+
+// ContentTypeGetSymbolicIcon is a wrapper around g_content_type_get_symbolic_icon().
+func ContentTypeGetSymbolicIcon(contentType string) *Icon {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_content_type_get_symbolic_icon(cstr)
+	if c == nil {
+		return nil
+	}
+	return takeIcon(c)
+}
+
+// ContentTypeGetGenericIconName is a wrapper around g_content_type_get_generic_icon_name().
+func ContentTypeGetGenericIconName(contentType string) string {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_content_type_get_generic_icon_name(cstr)
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c)
+}
+
+// ContentTypeCanBeExecutable is a wrapper around g_content_type_can_be_executable().
+func ContentTypeCanBeExecutable(contentType string) bool {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_content_type_can_be_executable(cstr))
+}
+
+// ContentTypesGetRegistered is a wrapper around g_content_types_get_registered().
+func ContentTypesGetRegistered() []string {
+	c := C.g_content_types_get_registered()
+	// Both the list and its strings are owned by the caller.
+	defer C.g_list_free_full(c, C.GDestroyNotify(C.g_free))
+
+	var types []string
+	for l := c; l != nil; l = l.next {
+		types = append(types, goString((*C.gchar)(l.data)))
+	}
+	return types
+}
+
+/*
+ * GFile default handler
+ */
+
+// GAppInfo *	g_file_query_default_handler ()
+func (v *File) QueryDefaultHandler(cancel *Cancellable) (*AppInfo, error) {
+	var err *C.GError
+	c := C.g_file_query_default_handler(v.native(), cancel.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return appInfoFull(c), nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_app_info_get_type()), marshalAppInfo},
+		{Type(C.g_app_launch_context_get_type()), marshalAppLaunchContext},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,66 @@
+package glib_test
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestContentTypes(t *testing.T) {
+	if typ, uncertain := glib.ContentTypeGuess("notes.txt", nil); typ != "text/plain" || uncertain {
+		t.Errorf("Expected a certain text/plain, got %q (uncertain %v)", typ, uncertain)
+	}
+	typ, _ := glib.ContentTypeGuess("", []byte("%PDF-1.4\n"))
+	if mime := glib.ContentTypeGetMimeType(typ); mime != "application/pdf" {
+		t.Errorf("Expected the data to be guessed as a PDF, got %q", mime)
+	}
+	if !glib.ContentTypeIsA(glib.ContentTypeFromMimeType("text/x-csrc"), "text/plain") {
+		t.Error("Expected C sources to be text files")
+	}
+}
+
+func TestContentTypeGetIcon(t *testing.T) {
+	icon := glib.ContentTypeGetIcon("text/plain")
+	symbolic := glib.ContentTypeGetSymbolicIcon("text/plain")
+	// The icons must keep their own references.
+	runtime.GC()
+	runtime.GC()
+	if s := icon.ToString(); !strings.Contains(s, "text-plain") {
+		t.Errorf("Expected a text-plain icon, got %q", s)
+	}
+	if s := symbolic.ToString(); !strings.Contains(s, "text-plain-symbolic") {
+		t.Errorf("Expected a text-plain-symbolic icon, got %q", s)
+	}
+}
+
+func TestAppInfoCreateFromCommandline(t *testing.T) {
+	info, err := glib.AppInfoCreateFromCommandline("/bin/true", "True", glib.APP_INFO_CREATE_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.GetName() != "True" || info.GetExecutable() != "/bin/true" {
+		t.Errorf("Expected the name and executable, got %q and %q",
+			info.GetName(), info.GetExecutable())
+	}
+	if info.SupportsURIs() {
+		t.Error("Expected the application not to support URIs")
+	}
+
+	context, err := glib.AppLaunchContextNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	context.Setenv("GOTK3_TEST", "1")
+	found := false
+	for _, env := range context.GetEnvironment() {
+		found = found || env == "GOTK3_TEST=1"
+	}
+	if !found {
+		t.Error("Expected the variable in the launch environment")
+	}
+	if err := info.Launch(nil, context); err != nil {
+		t.Error(err)
+	}
+}
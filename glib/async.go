@@ -0,0 +1,361 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "async.go.h"
+import "C"
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+// Priorities of main loop sources and asynchronous I/O operations.
+const (
+	PRIORITY_HIGH         int = C.G_PRIORITY_HIGH
+	PRIORITY_DEFAULT      int = C.G_PRIORITY_DEFAULT
+	PRIORITY_HIGH_IDLE    int = C.G_PRIORITY_HIGH_IDLE
+	PRIORITY_DEFAULT_IDLE int = C.G_PRIORITY_DEFAULT_IDLE
+	PRIORITY_LOW          int = C.G_PRIORITY_LOW
+)
+
+/*
+ * GAsyncResult
+ */
+
+// AsyncReadyCallback is the Go equivalent of GAsyncReadyCallback.
+//
+// The callback is invoked from the thread-default main context in use
+// when the asynchronous operation was started, which is the GTK+ main
+// loop for operations started from the main thread. The result should be
+// passed to the Finish method matching the operation to get its outcome.
+type AsyncReadyCallback func(source *Object, result *AsyncResult)
+
+// AsyncResult is a representation of GAsyncResult GInterface.
+type AsyncResult struct {
+	// Since GAsyncResult is based on GInterface, but require
+	// freed approach same as GObject has, use reference
+	// to Interface, instead of instance.
+	*Interface
+}
+
+// native() returns a pointer to the underlying GAsyncResult.
+func (v *AsyncResult) native() *C.GAsyncResult {
+	if v == nil || v.Interface == nil {
+		return nil
+	}
+	return C.toGAsyncResult(unsafe.Pointer(v.Native()))
+}
+
+func marshalAsyncResult(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	C.g_object_ref(C.gpointer(c))
+	return wrapAsyncResult(SetFinOnInterface(unsafe.Pointer(c))), nil
+}
+
+func wrapAsyncResult(intf *Interface) *AsyncResult {
+	return &AsyncResult{intf}
+}
+
+// GetSourceObject is a wrapper around g_async_result_get_source_object().
+func (v *AsyncResult) GetSourceObject() *Object {
+	c := C.g_async_result_get_source_object(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return obj
+}
+
+// AsyncResultChan returns an AsyncReadyCallback, along with the channel it
+// delivers the result to, for code which waits for completion instead of
+// handling it in a callback. The channel is buffered, so the callback never
+// blocks the main loop; since the callback is dispatched by the main loop,
+// the channel must not be received from the main loop thread.
+func AsyncResultChan() (AsyncReadyCallback, <-chan *AsyncResult) {
+	ch := make(chan *AsyncResult, 1)
+	return func(source *Object, result *AsyncResult) {
+		ch <- result
+	}, ch
+}
+
+var (
+	asyncReadyCallbackRegistry = struct {
+		sync.Mutex
+		next int
+		m    map[int]AsyncReadyCallback
+	}{
+		next: 1,
+		m:    make(map[int]AsyncReadyCallback),
+	}
+)
+
+// registerAsyncReadyCallback stores callback until the asynchronous
+// operation completes and returns the user data to pass along with
+// goAsyncReadyCallback.
+func registerAsyncReadyCallback(callback AsyncReadyCallback) C.gpointer {
+	asyncReadyCallbackRegistry.Lock()
+	id := asyncReadyCallbackRegistry.next
+	asyncReadyCallbackRegistry.next++
+	asyncReadyCallbackRegistry.m[id] = callback
+	asyncReadyCallbackRegistry.Unlock()
+
+	return C.gpointer(uintptr(id))
+}
+
+// unregisterAsyncReadyCallback drops a callback registered for an
+// operation which could not be started.
+func unregisterAsyncReadyCallback(data C.gpointer) {
+	asyncReadyCallbackRegistry.Lock()
+	delete(asyncReadyCallbackRegistry.m, int(uintptr(data)))
+	asyncReadyCallbackRegistry.Unlock()
+}
+
+// asyncReadyCallback returns goAsyncReadyCallback as a C function pointer.
+func asyncReadyCallback() C.GAsyncReadyCallback {
+	return C.GAsyncReadyCallback(C.goAsyncReadyCallback)
+}
+
+//export goAsyncReadyCallback
+func goAsyncReadyCallback(sourceObject *C.GObject, res *C.GAsyncResult,
+	userData C.gpointer) {
+
+	id := int(uintptr(userData))
+
+	asyncReadyCallbackRegistry.Lock()
+	fn := asyncReadyCallbackRegistry.m[id]
+	delete(asyncReadyCallbackRegistry.m, id)
+	asyncReadyCallbackRegistry.Unlock()
+
+	if fn == nil {
+		return
+	}
+
+	var source *Object
+	if sourceObject != nil {
+		source = wrapObject(unsafe.Pointer(sourceObject))
+	}
+	// The result may be kept after the callback returns,
+	// for example when it is sent over a channel.
+	C.g_object_ref(C.gpointer(res))
+	result := wrapAsyncResult(SetFinOnInterface(unsafe.Pointer(res)))
+
+	fn(source, result)
+}
+
+/*
+ * GTask
+ */
+
+// Task is a representation of GTask.
+//
+// Task lets asynchronous operations be implemented in Go, which report
+// their completion the same way GIO operations do: through an
+// AsyncReadyCallback run from the main context and a Propagate method
+// used by the matching Finish function.
+type Task struct {
+	*Object
+	// Interfaces
+	AsyncResult
+}
+
+// native() returns a pointer to the underlying GTask.
+func (v *Task) native() *C.GTask {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGTask(ptr)
+}
+
+func marshalTask(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapTask(obj), nil
+}
+
+func wrapTask(obj *Object) *Task {
+	result := wrapAsyncResult(InterfaceFromObjectNew(obj))
+	return &Task{obj, *result}
+}
+
+// TaskNew is a wrapper around g_task_new(). source may be nil.
+// The callback is invoked from the thread-default main context
+// of the caller once the task returns.
+func TaskNew(source IObject, cancel *Cancellable, callback AsyncReadyCallback) (*Task, error) {
+	var src C.gpointer
+	if source != nil {
+		src = C.gpointer(source.toObject().native())
+	}
+
+	data := registerAsyncReadyCallback(callback)
+	c := C._g_task_new(src, cancel.native(), data)
+	if c == nil {
+		unregisterAsyncReadyCallback(data)
+		return nil, errNilPtr
+	}
+
+	obj := wrapObject(unsafe.Pointer(c))
+	// g_task_new() returns a full reference,
+	// which is released once the task is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapTask(obj), nil
+}
+
+// TaskFromAsyncResult returns the Task behind result, which is how the
+// Finish function of an operation implemented with a Task gets access
+// to its outcome. An error is returned if result is not a GTask.
+func TaskFromAsyncResult(result *AsyncResult) (*Task, error) {
+	if !gobool(C.g_type_check_instance_is_a((*C.GTypeInstance)(unsafe.Pointer(result.native())),
+		C.g_task_get_type())) {
+		return nil, errors.New("result is not a GTask")
+	}
+	return wrapTask(wrapObject(unsafe.Pointer(result.native()))), nil
+}
+
+// GetCancellable is a wrapper around g_task_get_cancellable().
+func (v *Task) GetCancellable() *Cancellable {
+	c := C.g_task_get_cancellable(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapCancellable(wrapObject(unsafe.Pointer(c)))
+}
+
+// GetSourceObject is a wrapper around g_task_get_source_object().
+func (v *Task) GetSourceObject() *Object {
+	c := C.g_task_get_source_object(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapObject(unsafe.Pointer(c))
+}
+
+// SetCheckCancellable is a wrapper around g_task_set_check_cancellable().
+func (v *Task) SetCheckCancellable(checkCancellable bool) {
+	C.g_task_set_check_cancellable(v.native(), gbool(checkCancellable))
+}
+
+// SetReturnOnCancel is a wrapper around g_task_set_return_on_cancel().
+func (v *Task) SetReturnOnCancel(returnOnCancel bool) bool {
+	return gobool(C.g_task_set_return_on_cancel(v.native(), gbool(returnOnCancel)))
+}
+
+// HadError is a wrapper around g_task_had_error().
+func (v *Task) HadError() bool {
+	return gobool(C.g_task_had_error(v.native()))
+}
+
+// Run calls fn in a new goroutine, which is the Go counterpart of
+// g_task_run_in_thread(). fn must complete the task by calling one
+// of its Return methods, which are safe to use from any goroutine.
+func (v *Task) Run(fn func(task *Task, cancel *Cancellable)) {
+	go fn(v, v.GetCancellable())
+}
+
+// ReturnBoolean is a wrapper around g_task_return_boolean().
+func (v *Task) ReturnBoolean(result bool) {
+	C.g_task_return_boolean(v.native(), gbool(result))
+}
+
+// ReturnInt is a wrapper around g_task_return_int().
+func (v *Task) ReturnInt(result int64) {
+	C.g_task_return_int(v.native(), C.gssize(result))
+}
+
+// ReturnError is a wrapper around g_task_return_error().
+func (v *Task) ReturnError(err error) {
+	C.g_task_return_error(v.native(), newGError(err))
+}
+
+// ReturnErrorIfCancelled is a wrapper around g_task_return_error_if_cancelled().
+func (v *Task) ReturnErrorIfCancelled() bool {
+	return gobool(C.g_task_return_error_if_cancelled(v.native()))
+}
+
+var (
+	taskValueRegistry = struct {
+		sync.Mutex
+		next int
+		m    map[int]interface{}
+	}{
+		next: 1,
+		m:    make(map[int]interface{}),
+	}
+)
+
+//export goTaskValueDestroy
+func goTaskValueDestroy(data C.gpointer) {
+	id := int(uintptr(data))
+
+	taskValueRegistry.Lock()
+	delete(taskValueRegistry.m, id)
+	taskValueRegistry.Unlock()
+}
+
+// ReturnValue completes the task with an arbitrary Go value, which is
+// retrieved with PropagateValue. It is based on g_task_return_pointer().
+func (v *Task) ReturnValue(result interface{}) {
+	taskValueRegistry.Lock()
+	id := taskValueRegistry.next
+	taskValueRegistry.next++
+	taskValueRegistry.m[id] = result
+	taskValueRegistry.Unlock()
+
+	C._g_task_return_value(v.native(), C.gpointer(uintptr(id)))
+}
+
+// PropagateBoolean is a wrapper around g_task_propagate_boolean().
+func (v *Task) PropagateBoolean() (bool, error) {
+	var err *C.GError
+	c := C.g_task_propagate_boolean(v.native(), &err)
+	if err != nil {
+		return false, takeError(err)
+	}
+	return gobool(c), nil
+}
+
+// PropagateInt is a wrapper around g_task_propagate_int().
+func (v *Task) PropagateInt() (int64, error) {
+	var err *C.GError
+	c := C.g_task_propagate_int(v.native(), &err)
+	if err != nil {
+		return -1, takeError(err)
+	}
+	return int64(c), nil
+}
+
+// PropagateValue returns the value the task was completed with by
+// ReturnValue. It is based on g_task_propagate_pointer().
+func (v *Task) PropagateValue() (interface{}, error) {
+	var err *C.GError
+	c := C.g_task_propagate_pointer(v.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+
+	id := int(uintptr(c))
+
+	// Ownership of the value is transferred to the caller,
+	// so the destroy notify will never run.
+	taskValueRegistry.Lock()
+	result := taskValueRegistry.m[id]
+	delete(taskValueRegistry.m, id)
+	taskValueRegistry.Unlock()
+	return result, nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_async_result_get_type()), marshalAsyncResult},
+		{Type(C.g_task_get_type()), marshalTask},
+	}
+	RegisterGValueMarshalers(tm)
+}
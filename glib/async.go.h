@@ -0,0 +1,40 @@
+// Same copyright and license as the rest of the files in this project
+
+// GAsyncResult, GTask
+// See: https://developer.gnome.org/gio/stable/GAsyncResult.html
+
+#ifndef __GASYNC_GO_H__
+#define __GASYNC_GO_H__
+
+#include <gio/gio.h>
+
+extern void goAsyncReadyCallback(GObject *source_object, GAsyncResult *res,
+                                 gpointer user_data);
+extern void goTaskValueDestroy(gpointer data);
+
+static GAsyncResult *
+toGAsyncResult(void *p)
+{
+	return (G_ASYNC_RESULT(p));
+}
+
+static GTask *
+toGTask(void *p)
+{
+	return (G_TASK(p));
+}
+
+static GTask *
+_g_task_new(gpointer source_object, GCancellable *cancellable, gpointer id)
+{
+	return (g_task_new(source_object, cancellable,
+		(GAsyncReadyCallback)goAsyncReadyCallback, id));
+}
+
+static void
+_g_task_return_value(GTask *task, gpointer id)
+{
+	g_task_return_pointer(task, id, (GDestroyNotify)goTaskValueDestroy);
+}
+
+#endif
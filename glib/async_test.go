@@ -0,0 +1,111 @@
+package glib_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+// waitResult iterates the default main context until the operation
+// reporting to ch completes.
+func waitResult(t *testing.T, ch <-chan *glib.AsyncResult) *glib.AsyncResult {
+	t.Helper()
+	ctx := glib.MainContextDefault()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		select {
+		case result := <-ch:
+			return result
+		default:
+		}
+		if !ctx.Iteration(false) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	t.Fatal("Timed out waiting for the operation to complete")
+	return nil
+}
+
+func TestStreamsAsync(t *testing.T) {
+	out, err := glib.MemoryOutputStreamResizableNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := glib.BytesNew([]byte("asynchronous"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	callback, ch := glib.AsyncResultChan()
+	out.WriteBytesAsync(bytes, glib.PRIORITY_DEFAULT, nil, callback)
+	if n, err := out.WriteBytesFinish(waitResult(t, ch)); err != nil || n != bytes.GetSize() {
+		t.Fatalf("Expected to write %d bytes, got %d (%v)", bytes.GetSize(), n, err)
+	}
+	callback, ch = glib.AsyncResultChan()
+	out.CloseAsync(glib.PRIORITY_DEFAULT, nil, callback)
+	if err := out.CloseFinish(waitResult(t, ch)); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := out.StealAsBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := glib.MemoryInputStreamFromBytesNew(written)
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback, ch = glib.AsyncResultChan()
+	in.SkipAsync(1, glib.PRIORITY_DEFAULT, nil, callback)
+	if n, err := in.SkipFinish(waitResult(t, ch)); err != nil || n != 1 {
+		t.Fatalf("Expected to skip a byte, got %d (%v)", n, err)
+	}
+	callback, ch = glib.AsyncResultChan()
+	in.ReadBytesAsync(64, glib.PRIORITY_DEFAULT, nil, callback)
+	read, err := in.ReadBytesFinish(waitResult(t, ch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := string(read.GetData()); data != "synchronous" {
+		t.Errorf("Expected %q, got %q", "synchronous", data)
+	}
+}
+
+func TestTask(t *testing.T) {
+	callback, ch := glib.AsyncResultChan()
+	task, err := glib.TaskNew(nil, nil, callback)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.Run(func(task *glib.Task, cancel *glib.Cancellable) {
+		task.ReturnValue([]string{"from", "a", "goroutine"})
+	})
+
+	task, err = glib.TaskFromAsyncResult(waitResult(t, ch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := task.PropagateValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if words, ok := value.([]string); !ok || len(words) != 3 || words[2] != "goroutine" {
+		t.Errorf("Expected the returned words, got %v", value)
+	}
+
+	callback, ch = glib.AsyncResultChan()
+	task, err = glib.TaskNew(nil, nil, callback)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task.ReturnError(errors.New("failed"))
+	task, err = glib.TaskFromAsyncResult(waitResult(t, ch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := task.PropagateBoolean(); err == nil || err.Error() != "failed" {
+		t.Errorf("Expected the returned error, got %v", err)
+	}
+}
@@ -0,0 +1,476 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+/*
+ * GConverter
+ */
+
+// IConverter is an interface type implemented by all structs
+// embedding a Converter.
+type IConverter interface {
+	toConverter() *C.GConverter
+}
+
+// Converter is a representation of GConverter GInterface.
+type Converter struct {
+	Interface
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var _ IConverter = &Converter{}
+
+// native() returns a pointer to the underlying GConverter.
+func (v *Converter) native() *C.GConverter {
+	return C.toGConverter(unsafe.Pointer(v.Native()))
+}
+
+func (v *Converter) toConverter() *C.GConverter {
+	return v.native()
+}
+
+func marshalConverter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapConverter(*InterfaceFromObjectNew(obj)), nil
+}
+
+func wrapConverter(intf Interface) *Converter {
+	return &Converter{intf}
+}
+
+// Reset is a wrapper around g_converter_reset(). It resets the state of
+// the converter, so that it can be reused for a new stream.
+func (v *Converter) Reset() {
+	C.g_converter_reset(v.native())
+}
+
+/*
+ * GZlibCompressor
+ */
+
+// ZlibCompressorFormat is a representation of GIO's GZlibCompressorFormat.
+type ZlibCompressorFormat int
+
+const (
+	ZLIB_COMPRESSOR_FORMAT_ZLIB ZlibCompressorFormat = C.G_ZLIB_COMPRESSOR_FORMAT_ZLIB
+	ZLIB_COMPRESSOR_FORMAT_GZIP ZlibCompressorFormat = C.G_ZLIB_COMPRESSOR_FORMAT_GZIP
+	ZLIB_COMPRESSOR_FORMAT_RAW  ZlibCompressorFormat = C.G_ZLIB_COMPRESSOR_FORMAT_RAW
+)
+
+// ZlibCompressor is a representation of GZlibCompressor.
+type ZlibCompressor struct {
+	*Object
+	// Interfaces
+	Converter
+}
+
+// native() returns a pointer to the underlying GZlibCompressor.
+func (v *ZlibCompressor) native() *C.GZlibCompressor {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGZlibCompressor(ptr)
+}
+
+func marshalZlibCompressor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapZlibCompressor(obj), nil
+}
+
+func wrapZlibCompressor(obj *Object) *ZlibCompressor {
+	converter := wrapConverter(*InterfaceFromObjectNew(obj))
+	return &ZlibCompressor{obj, *converter}
+}
+
+// ZlibCompressorNew is a wrapper around g_zlib_compressor_new(). level
+// ranges from 0, no compression, to 9, best compression, or is -1 for
+// the default level of zlib.
+func ZlibCompressorNew(format ZlibCompressorFormat, level int) (*ZlibCompressor, error) {
+	c := C.g_zlib_compressor_new(C.GZlibCompressorFormat(format), C.int(level))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapZlibCompressor(obj), nil
+}
+
+// GetFileInfo is a wrapper around g_zlib_compressor_get_file_info().
+func (v *ZlibCompressor) GetFileInfo() *FileInfo {
+	c := C.g_zlib_compressor_get_file_info(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapFileInfo(wrapObject(unsafe.Pointer(c)))
+}
+
+// SetFileInfo is a wrapper around g_zlib_compressor_set_file_info(). With
+// the gzip format, the name and modification time of info are stored in
+// the header of the compressed data. It must be called before any data
+// is compressed, or after a Reset.
+func (v *ZlibCompressor) SetFileInfo(info *FileInfo) {
+	C.g_zlib_compressor_set_file_info(v.native(), info.native())
+}
+
+/*
+ * GZlibDecompressor
+ */
+
+// ZlibDecompressor is a representation of GZlibDecompressor.
+type ZlibDecompressor struct {
+	*Object
+	// Interfaces
+	Converter
+}
+
+// native() returns a pointer to the underlying GZlibDecompressor.
+func (v *ZlibDecompressor) native() *C.GZlibDecompressor {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGZlibDecompressor(ptr)
+}
+
+func marshalZlibDecompressor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapZlibDecompressor(obj), nil
+}
+
+func wrapZlibDecompressor(obj *Object) *ZlibDecompressor {
+	converter := wrapConverter(*InterfaceFromObjectNew(obj))
+	return &ZlibDecompressor{obj, *converter}
+}
+
+// ZlibDecompressorNew is a wrapper around g_zlib_decompressor_new().
+func ZlibDecompressorNew(format ZlibCompressorFormat) (*ZlibDecompressor, error) {
+	c := C.g_zlib_decompressor_new(C.GZlibCompressorFormat(format))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapZlibDecompressor(obj), nil
+}
+
+// GetFileInfo is a wrapper around g_zlib_decompressor_get_file_info().
+// With the gzip format, it returns the name and modification time stored
+// in the header, once the header has been decompressed, or nil.
+func (v *ZlibDecompressor) GetFileInfo() *FileInfo {
+	c := C.g_zlib_decompressor_get_file_info(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapFileInfo(wrapObject(unsafe.Pointer(c)))
+}
+
+/*
+ * GCharsetConverter
+ */
+
+// CharsetConverter is a representation of GCharsetConverter.
+type CharsetConverter struct {
+	*Object
+	// Interfaces
+	Converter
+}
+
+// native() returns a pointer to the underlying GCharsetConverter.
+func (v *CharsetConverter) native() *C.GCharsetConverter {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGCharsetConverter(ptr)
+}
+
+func marshalCharsetConverter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapCharsetConverter(obj), nil
+}
+
+func wrapCharsetConverter(obj *Object) *CharsetConverter {
+	converter := wrapConverter(*InterfaceFromObjectNew(obj))
+	return &CharsetConverter{obj, *converter}
+}
+
+// CharsetConverterNew is a wrapper around g_charset_converter_new(). It
+// converts from the charset fromCharset, like "ISO-8859-1", to toCharset,
+// like "UTF-8". An error is returned if the conversion is not supported.
+func CharsetConverterNew(toCharset, fromCharset string) (*CharsetConverter, error) {
+	cto := C.CString(toCharset)
+	defer C.free(unsafe.Pointer(cto))
+	cfrom := C.CString(fromCharset)
+	defer C.free(unsafe.Pointer(cfrom))
+
+	var err *C.GError
+	c := C.g_charset_converter_new((*C.gchar)(cto), (*C.gchar)(cfrom), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapCharsetConverter(obj), nil
+}
+
+// SetUseFallback is a wrapper around g_charset_converter_set_use_fallback().
+// When enabled, characters which cannot be represented in the target
+// charset are replaced by an escape sequence instead of failing the
+// conversion.
+func (v *CharsetConverter) SetUseFallback(useFallback bool) {
+	C.g_charset_converter_set_use_fallback(v.native(), gbool(useFallback))
+}
+
+// GetUseFallback is a wrapper around g_charset_converter_get_use_fallback().
+func (v *CharsetConverter) GetUseFallback() bool {
+	c := C.g_charset_converter_get_use_fallback(v.native())
+	return gobool(c)
+}
+
+// GetNumFallbacks is a wrapper around g_charset_converter_get_num_fallbacks().
+func (v *CharsetConverter) GetNumFallbacks() uint {
+	c := C.g_charset_converter_get_num_fallbacks(v.native())
+	return uint(c)
+}
+
+/*
+ * GFilterInputStream
+ */
+
+// FilterInputStream is a representation of GFilterInputStream, the base
+// class of the input streams wrapping another one.
+type FilterInputStream struct {
+	InputStream
+}
+
+// native() returns a pointer to the underlying GFilterInputStream.
+func (v *FilterInputStream) native() *C.GFilterInputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGFilterInputStream(ptr)
+}
+
+func marshalFilterInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFilterInputStream(obj), nil
+}
+
+func wrapFilterInputStream(obj *Object) *FilterInputStream {
+	return &FilterInputStream{InputStream{obj}}
+}
+
+// GetBaseStream is a wrapper around g_filter_input_stream_get_base_stream().
+func (v *FilterInputStream) GetBaseStream() *InputStream {
+	c := C.g_filter_input_stream_get_base_stream(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapInputStream(wrapObject(unsafe.Pointer(c)))
+}
+
+// GetCloseBaseStream is a wrapper around
+// g_filter_input_stream_get_close_base_stream().
+func (v *FilterInputStream) GetCloseBaseStream() bool {
+	c := C.g_filter_input_stream_get_close_base_stream(v.native())
+	return gobool(c)
+}
+
+// SetCloseBaseStream is a wrapper around
+// g_filter_input_stream_set_close_base_stream(). The base stream is
+// closed along with the filter stream by default.
+func (v *FilterInputStream) SetCloseBaseStream(closeBase bool) {
+	C.g_filter_input_stream_set_close_base_stream(v.native(), gbool(closeBase))
+}
+
+/*
+ * GFilterOutputStream
+ */
+
+// FilterOutputStream is a representation of GFilterOutputStream, the base
+// class of the output streams wrapping another one.
+type FilterOutputStream struct {
+	OutputStream
+}
+
+// native() returns a pointer to the underlying GFilterOutputStream.
+func (v *FilterOutputStream) native() *C.GFilterOutputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGFilterOutputStream(ptr)
+}
+
+func marshalFilterOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFilterOutputStream(obj), nil
+}
+
+func wrapFilterOutputStream(obj *Object) *FilterOutputStream {
+	return &FilterOutputStream{OutputStream{obj}}
+}
+
+// GetBaseStream is a wrapper around g_filter_output_stream_get_base_stream().
+func (v *FilterOutputStream) GetBaseStream() *OutputStream {
+	c := C.g_filter_output_stream_get_base_stream(v.native())
+	if c == nil {
+		return nil
+	}
+	return wrapOutputStream(wrapObject(unsafe.Pointer(c)))
+}
+
+// GetCloseBaseStream is a wrapper around
+// g_filter_output_stream_get_close_base_stream().
+func (v *FilterOutputStream) GetCloseBaseStream() bool {
+	c := C.g_filter_output_stream_get_close_base_stream(v.native())
+	return gobool(c)
+}
+
+// SetCloseBaseStream is a wrapper around
+// g_filter_output_stream_set_close_base_stream(). The base stream is
+// closed along with the filter stream by default.
+func (v *FilterOutputStream) SetCloseBaseStream(closeBase bool) {
+	C.g_filter_output_stream_set_close_base_stream(v.native(), gbool(closeBase))
+}
+
+/*
+ * GConverterInputStream
+ */
+
+// ConverterInputStream is a representation of GConverterInputStream. The
+// data read from it is the one of its base stream, converted by its
+// converter, like a ZlibDecompressor to read compressed files.
+type ConverterInputStream struct {
+	FilterInputStream
+}
+
+// native() returns a pointer to the underlying GConverterInputStream.
+func (v *ConverterInputStream) native() *C.GConverterInputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGConverterInputStream(ptr)
+}
+
+func marshalConverterInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapConverterInputStream(obj), nil
+}
+
+func wrapConverterInputStream(obj *Object) *ConverterInputStream {
+	return &ConverterInputStream{FilterInputStream{InputStream{obj}}}
+}
+
+// ConverterInputStreamNew is a wrapper around g_converter_input_stream_new().
+func ConverterInputStreamNew(baseStream *InputStream, converter IConverter) (*ConverterInputStream, error) {
+	c := C.g_converter_input_stream_new(baseStream.native(), converter.toConverter())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapConverterInputStream(obj), nil
+}
+
+// GetConverter is a wrapper around g_converter_input_stream_get_converter().
+func (v *ConverterInputStream) GetConverter() *Converter {
+	c := C.g_converter_input_stream_get_converter(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	return wrapConverter(*InterfaceFromObjectNew(obj))
+}
+
+/*
+ * GConverterOutputStream
+ */
+
+// ConverterOutputStream is a representation of GConverterOutputStream. The
+// data written to it is converted by its converter, like a ZlibCompressor,
+// before being written to its base stream. It must be closed, so that
+// the converter writes any pending data.
+type ConverterOutputStream struct {
+	FilterOutputStream
+}
+
+// native() returns a pointer to the underlying GConverterOutputStream.
+func (v *ConverterOutputStream) native() *C.GConverterOutputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGConverterOutputStream(ptr)
+}
+
+func marshalConverterOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapConverterOutputStream(obj), nil
+}
+
+func wrapConverterOutputStream(obj *Object) *ConverterOutputStream {
+	return &ConverterOutputStream{FilterOutputStream{OutputStream{obj}}}
+}
+
+// ConverterOutputStreamNew is a wrapper around g_converter_output_stream_new().
+func ConverterOutputStreamNew(baseStream *OutputStream, converter IConverter) (*ConverterOutputStream, error) {
+	c := C.g_converter_output_stream_new(baseStream.native(), converter.toConverter())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapConverterOutputStream(obj), nil
+}
+
+// GetConverter is a wrapper around g_converter_output_stream_get_converter().
+func (v *ConverterOutputStream) GetConverter() *Converter {
+	c := C.g_converter_output_stream_get_converter(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	return wrapConverter(*InterfaceFromObjectNew(obj))
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_converter_get_type()), marshalConverter},
+		{Type(C.g_zlib_compressor_get_type()), marshalZlibCompressor},
+		{Type(C.g_zlib_decompressor_get_type()), marshalZlibDecompressor},
+		{Type(C.g_charset_converter_get_type()), marshalCharsetConverter},
+		{Type(C.g_filter_input_stream_get_type()), marshalFilterInputStream},
+		{Type(C.g_filter_output_stream_get_type()), marshalFilterOutputStream},
+		{Type(C.g_converter_input_stream_get_type()), marshalConverterInputStream},
+		{Type(C.g_converter_output_stream_get_type()), marshalConverterOutputStream},
+	}
+	RegisterGValueMarshalers(tm)
+}
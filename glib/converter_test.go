@@ -0,0 +1,103 @@
+package glib_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func readAll(t *testing.T, in *glib.InputStream) []byte {
+	var data []byte
+	b := make([]byte, 256)
+	for {
+		n, err := in.Read(b, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n == 0 {
+			return data
+		}
+		data = append(data, b[:n]...)
+	}
+}
+
+func TestConverterStreams_Gzip(t *testing.T) {
+	expected := bytes.Repeat([]byte("hello, world\n"), 100)
+
+	out, err := glib.MemoryOutputStreamResizableNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressor, err := glib.ZlibCompressorNew(glib.ZLIB_COMPRESSOR_FORMAT_GZIP, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gzipOut, err := glib.ConverterOutputStreamNew(&out.OutputStream, compressor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gzipOut.WriteAll(expected, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipOut.Close(nil); err != nil {
+		t.Fatal(err)
+	}
+	compressed, err := out.StealAsBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The data is compressed in the format of the gzip tool.
+	r, err := gzip.NewReader(bytes.NewReader(compressed.GetData()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, err := io.ReadAll(r); err != nil || !bytes.Equal(data, expected) {
+		t.Errorf("Expected the compressed data to be readable by compress/gzip, got %v", err)
+	}
+
+	in, err := glib.MemoryInputStreamFromBytesNew(compressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decompressor, err := glib.ZlibDecompressorNew(glib.ZLIB_COMPRESSOR_FORMAT_GZIP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gzipIn, err := glib.ConverterInputStreamNew(&in.InputStream, decompressor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data := readAll(t, &gzipIn.InputStream); !bytes.Equal(data, expected) {
+		t.Errorf("Expected %d decompressed bytes, got %q", len(expected), data)
+	}
+}
+
+func TestConverterStreams_Charset(t *testing.T) {
+	latin1, err := glib.BytesNew([]byte("caf\xe9 cr\xe8me"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := glib.MemoryInputStreamFromBytesNew(latin1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	converter, err := glib.CharsetConverterNew("UTF-8", "ISO-8859-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	utf8In, err := glib.ConverterInputStreamNew(&in.InputStream, converter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(readAll(t, &utf8In.InputStream)); s != "café crème" {
+		t.Errorf("Expected %q, got %q", "café crème", s)
+	}
+
+	if _, err := glib.CharsetConverterNew("UTF-8", "NO-SUCH-CHARSET"); err == nil {
+		t.Error("Expected an error for an unknown charset")
+	}
+}
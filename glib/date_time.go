@@ -0,0 +1,487 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"time"
+	"unsafe"
+)
+
+/*
+ * GTimeZone
+ */
+
+// TimeZone is a representation of GLib's GTimeZone.
+type TimeZone struct {
+	tz *C.GTimeZone
+}
+
+// wrapTimeZone wraps a full reference to a GTimeZone, which is released
+// once the TimeZone is garbage collected.
+func wrapTimeZone(tz *C.GTimeZone) *TimeZone {
+	v := &TimeZone{tz}
+	runtime.SetFinalizer(v, func(v *TimeZone) {
+		C.g_time_zone_unref(v.tz)
+	})
+	return v
+}
+
+func (v *TimeZone) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *TimeZone) native() *C.GTimeZone {
+	if v == nil {
+		return nil
+	}
+	return v.tz
+}
+
+func marshalTimeZone(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return (*TimeZone)(nil), nil
+	}
+	tz := (*C.GTimeZone)(unsafe.Pointer(c))
+	return wrapTimeZone(C.g_time_zone_ref(tz)), nil
+}
+
+// TimeZoneNew is a wrapper around g_time_zone_new(). identifier is either
+// an offset, like "+02:00", or a zone of the tz database, like
+// "Europe/Paris". The UTC zone is returned if identifier is invalid, and
+// the local zone if it is empty.
+func TimeZoneNew(identifier string) *TimeZone {
+	if identifier == "" {
+		return TimeZoneNewLocal()
+	}
+	cstr := C.CString(identifier)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return wrapTimeZone(C.g_time_zone_new((*C.gchar)(cstr)))
+}
+
+// TimeZoneNewLocal is a wrapper around g_time_zone_new_local().
+func TimeZoneNewLocal() *TimeZone {
+	return wrapTimeZone(C.g_time_zone_new_local())
+}
+
+// TimeZoneNewUTC is a wrapper around g_time_zone_new_utc().
+func TimeZoneNewUTC() *TimeZone {
+	return wrapTimeZone(C.g_time_zone_new_utc())
+}
+
+// timeZoneOffset returns a TimeZone of a fixed offset.
+func timeZoneOffset(offset int) *TimeZone {
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	identifier := fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		identifier += fmt.Sprintf(":%02d", offset%60)
+	}
+	return TimeZoneNew(identifier)
+}
+
+// FindInterval is a wrapper around g_time_zone_find_interval().
+func (v *TimeZone) FindInterval(t TimeType, unix int64) int {
+	return int(C.g_time_zone_find_interval(v.native(), C.GTimeType(t), C.gint64(unix)))
+}
+
+// GetAbbreviation is a wrapper around g_time_zone_get_abbreviation().
+func (v *TimeZone) GetAbbreviation(interval int) string {
+	return goString(C.g_time_zone_get_abbreviation(v.native(), C.gint(interval)))
+}
+
+// GetOffset is a wrapper around g_time_zone_get_offset().
+func (v *TimeZone) GetOffset(interval int) time.Duration {
+	return time.Duration(C.g_time_zone_get_offset(v.native(), C.gint(interval))) * time.Second
+}
+
+// IsDST is a wrapper around g_time_zone_is_dst().
+func (v *TimeZone) IsDST(interval int) bool {
+	return gobool(C.g_time_zone_is_dst(v.native(), C.gint(interval)))
+}
+
+// TimeType is a representation of GLib's GTimeType.
+type TimeType int
+
+const (
+	TIME_TYPE_STANDARD  TimeType = C.G_TIME_TYPE_STANDARD
+	TIME_TYPE_DAYLIGHT  TimeType = C.G_TIME_TYPE_DAYLIGHT
+	TIME_TYPE_UNIVERSAL TimeType = C.G_TIME_TYPE_UNIVERSAL
+)
+
+/*
+ * GDateTime
+ */
+
+// DateTime is a representation of GLib's GDateTime, a date and time of a
+// time zone, with a precision of a microsecond, between the years 1 and
+// 9999. It converts to and from time.Time with DateTimeNewFromTime and
+// Time.
+type DateTime struct {
+	dateTime *C.GDateTime
+}
+
+// wrapDateTime wraps a full reference to a GDateTime, which is released
+// once the DateTime is garbage collected. It returns nil if dateTime is
+// nil.
+func wrapDateTime(dateTime *C.GDateTime) *DateTime {
+	if dateTime == nil {
+		return nil
+	}
+	v := &DateTime{dateTime}
+	runtime.SetFinalizer(v, func(v *DateTime) {
+		C.g_date_time_unref(v.dateTime)
+	})
+	return v
+}
+
+func (v *DateTime) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *DateTime) native() *C.GDateTime {
+	if v == nil {
+		return nil
+	}
+	return v.dateTime
+}
+
+func marshalDateTime(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return (*DateTime)(nil), nil
+	}
+	dateTime := (*C.GDateTime)(unsafe.Pointer(c))
+	return wrapDateTime(C.g_date_time_ref(dateTime)), nil
+}
+
+var errDateTimeRange = errors.New("date and time out of range")
+
+// DateTimeNew is a wrapper around g_date_time_new(). An error is returned
+// if the date is invalid or out of range.
+func DateTimeNew(tz *TimeZone, year, month, day, hour, minute int, seconds float64) (*DateTime, error) {
+	c := C.g_date_time_new(tz.native(), C.gint(year), C.gint(month), C.gint(day),
+		C.gint(hour), C.gint(minute), C.gdouble(seconds))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// DateTimeNewNow is a wrapper around g_date_time_new_now().
+func DateTimeNewNow(tz *TimeZone) *DateTime {
+	return wrapDateTime(C.g_date_time_new_now(tz.native()))
+}
+
+// DateTimeNewNowLocal is a wrapper around g_date_time_new_now_local().
+func DateTimeNewNowLocal() *DateTime {
+	return wrapDateTime(C.g_date_time_new_now_local())
+}
+
+// DateTimeNewNowUTC is a wrapper around g_date_time_new_now_utc().
+func DateTimeNewNowUTC() *DateTime {
+	return wrapDateTime(C.g_date_time_new_now_utc())
+}
+
+// DateTimeNewFromUnixLocal is a wrapper around
+// g_date_time_new_from_unix_local().
+func DateTimeNewFromUnixLocal(t int64) (*DateTime, error) {
+	c := C.g_date_time_new_from_unix_local(C.gint64(t))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// DateTimeNewFromUnixUTC is a wrapper around g_date_time_new_from_unix_utc().
+func DateTimeNewFromUnixUTC(t int64) (*DateTime, error) {
+	c := C.g_date_time_new_from_unix_utc(C.gint64(t))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// DateTimeNewFromTime returns a DateTime of the same instant and UTC
+// offset as t, truncated to the microsecond. Its time zone is the one
+// of GLib matching the location of t if any, like "Europe/Paris" or
+// the local zone, so that the time zone abbreviation and daylight saving
+// time are kept, or else a zone of the fixed offset of t.
+func DateTimeNewFromTime(t time.Time) (*DateTime, error) {
+	utc := C.g_date_time_new_from_unix_utc(C.gint64(t.Unix()))
+	if utc == nil {
+		return nil, errDateTimeRange
+	}
+	defer C.g_date_time_unref(utc)
+	instant := C.g_date_time_add(utc, C.GTimeSpan(t.Nanosecond()/int(time.Microsecond)))
+	if instant == nil {
+		return nil, errDateTimeRange
+	}
+	defer C.g_date_time_unref(instant)
+
+	var tz *TimeZone
+	switch loc := t.Location(); loc {
+	case time.UTC:
+		tz = TimeZoneNewUTC()
+	case time.Local:
+		tz = TimeZoneNewLocal()
+	default:
+		tz = TimeZoneNew(loc.String())
+	}
+	_, offset := t.Zone()
+
+	c := C.g_date_time_to_timezone(instant, tz.native())
+	if c != nil && time.Duration(C.g_date_time_get_utc_offset(c))*time.Microsecond !=
+		time.Duration(offset)*time.Second {
+		// The zones of Go and GLib do not agree, or GLib does not know
+		// the zone of Go.
+		C.g_date_time_unref(c)
+		c = C.g_date_time_to_timezone(instant, timeZoneOffset(offset).native())
+	}
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// Time returns the time.Time of the same instant and UTC offset as v.
+// Its location is a fixed zone named after the time zone abbreviation of
+// v, like "CEST", since the identifier of the zone of v is not known.
+func (v *DateTime) Time() time.Time {
+	unix := int64(C.g_date_time_to_unix(v.native()))
+	usec := int64(C.g_date_time_get_microsecond(v.native()))
+	offset := v.GetUTCOffset() / time.Second
+
+	zone := time.FixedZone(v.GetTimezoneAbbreviation(), int(offset))
+	return time.Unix(unix, usec*int64(time.Microsecond)).In(zone)
+}
+
+// String returns v in the ISO 8601 format.
+func (v *DateTime) String() string {
+	s, _ := v.Format("%Y-%m-%dT%H:%M:%S%:z")
+	return s
+}
+
+// Format is a wrapper around g_date_time_format(). The format is the one
+// of strftime(), with locale aware conversions such as "%x" for the
+// preferred date representation of the current locale, "%c" for the date
+// and time, and "%X" for the time. An error is returned if format is
+// invalid.
+func (v *DateTime) Format(format string) (string, error) {
+	cstr := C.CString(format)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_date_time_format(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return "", fmt.Errorf("invalid date and time format: %q", format)
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c), nil
+}
+
+// Add is a wrapper around g_date_time_add(). d is truncated to the
+// microsecond.
+func (v *DateTime) Add(d time.Duration) (*DateTime, error) {
+	c := C.g_date_time_add(v.native(), C.GTimeSpan(d/time.Microsecond))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// AddFull is a wrapper around g_date_time_add_full().
+func (v *DateTime) AddFull(years, months, days, hours, minutes int, seconds float64) (*DateTime, error) {
+	c := C.g_date_time_add_full(v.native(), C.gint(years), C.gint(months), C.gint(days),
+		C.gint(hours), C.gint(minutes), C.gdouble(seconds))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// AddYears is a wrapper around g_date_time_add_years().
+func (v *DateTime) AddYears(years int) (*DateTime, error) {
+	c := C.g_date_time_add_years(v.native(), C.gint(years))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// AddMonths is a wrapper around g_date_time_add_months().
+func (v *DateTime) AddMonths(months int) (*DateTime, error) {
+	c := C.g_date_time_add_months(v.native(), C.gint(months))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// AddDays is a wrapper around g_date_time_add_days().
+func (v *DateTime) AddDays(days int) (*DateTime, error) {
+	c := C.g_date_time_add_days(v.native(), C.gint(days))
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// Difference is a wrapper around g_date_time_difference(). It returns the
+// duration from begin to v.
+func (v *DateTime) Difference(begin *DateTime) time.Duration {
+	return time.Duration(C.g_date_time_difference(v.native(), begin.native())) * time.Microsecond
+}
+
+// Compare is a wrapper around g_date_time_compare(). It returns -1, 0 or
+// 1 if v is before, at the same instant as, or after other.
+func (v *DateTime) Compare(other *DateTime) int {
+	return int(C.g_date_time_compare(C.gconstpointer(unsafe.Pointer(v.native())),
+		C.gconstpointer(unsafe.Pointer(other.native()))))
+}
+
+// Equal is a wrapper around g_date_time_equal(). It reports whether v and
+// other are the same instant, whatever their time zones.
+func (v *DateTime) Equal(other *DateTime) bool {
+	return gobool(C.g_date_time_equal(C.gconstpointer(unsafe.Pointer(v.native())),
+		C.gconstpointer(unsafe.Pointer(other.native()))))
+}
+
+// ToTimezone is a wrapper around g_date_time_to_timezone().
+func (v *DateTime) ToTimezone(tz *TimeZone) (*DateTime, error) {
+	c := C.g_date_time_to_timezone(v.native(), tz.native())
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// ToLocal is a wrapper around g_date_time_to_local().
+func (v *DateTime) ToLocal() (*DateTime, error) {
+	c := C.g_date_time_to_local(v.native())
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// ToUTC is a wrapper around g_date_time_to_utc().
+func (v *DateTime) ToUTC() (*DateTime, error) {
+	c := C.g_date_time_to_utc(v.native())
+	if c == nil {
+		return nil, errDateTimeRange
+	}
+	return wrapDateTime(c), nil
+}
+
+// ToUnix is a wrapper around g_date_time_to_unix().
+func (v *DateTime) ToUnix() int64 {
+	return int64(C.g_date_time_to_unix(v.native()))
+}
+
+// GetYMD is a wrapper around g_date_time_get_ymd().
+func (v *DateTime) GetYMD() (year, month, day int) {
+	var y, m, d C.gint
+	C.g_date_time_get_ymd(v.native(), &y, &m, &d)
+	return int(y), int(m), int(d)
+}
+
+// GetYear is a wrapper around g_date_time_get_year().
+func (v *DateTime) GetYear() int {
+	return int(C.g_date_time_get_year(v.native()))
+}
+
+// GetMonth is a wrapper around g_date_time_get_month(). January is 1.
+func (v *DateTime) GetMonth() int {
+	return int(C.g_date_time_get_month(v.native()))
+}
+
+// GetDayOfMonth is a wrapper around g_date_time_get_day_of_month().
+func (v *DateTime) GetDayOfMonth() int {
+	return int(C.g_date_time_get_day_of_month(v.native()))
+}
+
+// GetWeekNumberingYear is a wrapper around
+// g_date_time_get_week_numbering_year(). It is the ISO 8601 year of the
+// week returned by GetWeekOfYear.
+func (v *DateTime) GetWeekNumberingYear() int {
+	return int(C.g_date_time_get_week_numbering_year(v.native()))
+}
+
+// GetWeekOfYear is a wrapper around g_date_time_get_week_of_year(). It
+// returns the ISO 8601 week, from 1 to 53.
+func (v *DateTime) GetWeekOfYear() int {
+	return int(C.g_date_time_get_week_of_year(v.native()))
+}
+
+// GetDayOfWeek is a wrapper around g_date_time_get_day_of_week(). Monday
+// is 1 and Sunday is 7.
+func (v *DateTime) GetDayOfWeek() int {
+	return int(C.g_date_time_get_day_of_week(v.native()))
+}
+
+// GetDayOfYear is a wrapper around g_date_time_get_day_of_year(). January
+// the 1st is 1.
+func (v *DateTime) GetDayOfYear() int {
+	return int(C.g_date_time_get_day_of_year(v.native()))
+}
+
+// GetHour is a wrapper around g_date_time_get_hour().
+func (v *DateTime) GetHour() int {
+	return int(C.g_date_time_get_hour(v.native()))
+}
+
+// GetMinute is a wrapper around g_date_time_get_minute().
+func (v *DateTime) GetMinute() int {
+	return int(C.g_date_time_get_minute(v.native()))
+}
+
+// GetSecond is a wrapper around g_date_time_get_second().
+func (v *DateTime) GetSecond() int {
+	return int(C.g_date_time_get_second(v.native()))
+}
+
+// GetMicrosecond is a wrapper around g_date_time_get_microsecond().
+func (v *DateTime) GetMicrosecond() int {
+	return int(C.g_date_time_get_microsecond(v.native()))
+}
+
+// GetSeconds is a wrapper around g_date_time_get_seconds(). It returns
+// the seconds, including the fractional part.
+func (v *DateTime) GetSeconds() float64 {
+	return float64(C.g_date_time_get_seconds(v.native()))
+}
+
+// GetUTCOffset is a wrapper around g_date_time_get_utc_offset().
+func (v *DateTime) GetUTCOffset() time.Duration {
+	return time.Duration(C.g_date_time_get_utc_offset(v.native())) * time.Microsecond
+}
+
+// GetTimezoneAbbreviation is a wrapper around
+// g_date_time_get_timezone_abbreviation().
+func (v *DateTime) GetTimezoneAbbreviation() string {
+	return goString(C.g_date_time_get_timezone_abbreviation(v.native()))
+}
+
+// IsDaylightSavings is a wrapper around g_date_time_is_daylight_savings().
+func (v *DateTime) IsDaylightSavings() bool {
+	return gobool(C.g_date_time_is_daylight_savings(v.native()))
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_time_zone_get_type()), marshalTimeZone},
+		{Type(C.g_date_time_get_type()), marshalDateTime},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,94 @@
+package glib_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestDateTimeNewFromTime(t *testing.T) {
+	zones := []*time.Location{time.UTC, time.FixedZone("", 5*3600+1800)}
+	if paris, err := time.LoadLocation("Europe/Paris"); err == nil {
+		zones = append(zones, paris)
+	}
+
+	for _, zone := range zones {
+		expected := time.Date(2021, time.March, 28, 3, 30, 15, 123456789, zone)
+		dateTime, err := glib.DateTimeNewFromTime(expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if year, month, day := dateTime.GetYMD(); year != 2021 || month != 3 || day != 28 {
+			t.Errorf("%s: Expected 2021-03-28, got %d-%02d-%02d", zone, year, month, day)
+		}
+		if hour := dateTime.GetHour(); hour != 3 {
+			t.Errorf("%s: Expected hour 3, got %d", zone, hour)
+		}
+
+		got := dateTime.Time()
+		if !got.Equal(expected.Truncate(time.Microsecond)) {
+			t.Errorf("%s: Expected %s, got %s", zone, expected, got)
+		}
+		_, expectedOffset := expected.Zone()
+		if _, offset := got.Zone(); offset != expectedOffset {
+			t.Errorf("%s: Expected offset %d, got %d", zone, expectedOffset, offset)
+		}
+	}
+}
+
+func TestDateTime_Format(t *testing.T) {
+	dateTime, err := glib.DateTimeNew(glib.TimeZoneNewUTC(), 2020, 12, 31, 23, 59, 30.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := dateTime.Format("%Y-%m-%d %H:%M:%S")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != "2020-12-31 23:59:30" {
+		t.Errorf("Expected %q, got %q", "2020-12-31 23:59:30", s)
+	}
+	if day := dateTime.GetDayOfYear(); day != 366 {
+		t.Errorf("Expected day of year 366, got %d", day)
+	}
+	if week := dateTime.GetWeekOfYear(); week != 53 {
+		t.Errorf("Expected week 53, got %d", week)
+	}
+
+	if _, err := glib.DateTimeNew(glib.TimeZoneNewUTC(), 2021, 2, 29, 0, 0, 0); err == nil {
+		t.Error("Expected an error for February the 29th of 2021")
+	}
+}
+
+func TestDateTime_AddCalendar(t *testing.T) {
+	dateTime, err := glib.DateTimeNew(glib.TimeZoneNewUTC(), 2024, 1, 31, 12, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The day is clamped to the end of shorter months.
+	month, err := dateTime.AddMonths(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if year, month, day := month.GetYMD(); year != 2024 || month != 2 || day != 29 {
+		t.Errorf("Expected 2024-02-29, got %d-%02d-%02d", year, month, day)
+	}
+	year, err := month.AddYears(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if year, month, day := year.GetYMD(); year != 2025 || month != 2 || day != 28 {
+		t.Errorf("Expected 2025-02-28, got %d-%02d-%02d", year, month, day)
+	}
+	days, err := dateTime.AddDays(-31)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if year, month, day := days.GetYMD(); year != 2023 || month != 12 || day != 31 {
+		t.Errorf("Expected 2023-12-31, got %d-%02d-%02d", year, month, day)
+	}
+}
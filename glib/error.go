@@ -0,0 +1,215 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"context"
+	"os"
+	"unsafe"
+)
+
+// QuarkFromString is a wrapper around g_quark_from_string().
+func QuarkFromString(s string) Quark {
+	cstr := C.CString(s)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return Quark(C.g_quark_from_string((*C.gchar)(cstr)))
+}
+
+// String is a wrapper around g_quark_to_string().
+func (q Quark) String() string {
+	return goString(C.g_quark_to_string(C.GQuark(q)))
+}
+
+/*
+ * GError
+ */
+
+// Error is a representation of GLib's GError. It is returned by every
+// function wrapping a GLib, GIO or GTK function reporting a GError, so
+// that errors can be told apart by their domain and code rather than by
+// their message:
+//
+//	_, err := file.Read(nil)
+//	if errors.Is(err, glib.ErrIONotFound) {
+//		...
+//	}
+//
+// Errors of the IO_ERROR_NOT_FOUND, IO_ERROR_EXISTS and
+// IO_ERROR_PERMISSION_DENIED codes also match os.ErrNotExist, os.ErrExist
+// and os.ErrPermission, and IO_ERROR_CANCELLED matches context.Canceled.
+type Error struct {
+	Domain  Quark
+	Code    int
+	Message string
+}
+
+// NewError returns an Error of the given domain and code.
+func NewError(domain Quark, code int, message string) *Error {
+	return &Error{Domain: domain, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Matches is a wrapper around g_error_matches().
+func (e *Error) Matches(domain Quark, code int) bool {
+	return e != nil && e.Domain == domain && e.Code == code
+}
+
+// Is reports whether e matches target, which is either an Error of the
+// same domain and code, such as one of the Err sentinel values, or the
+// Go error equivalent to an IO error.
+func (e *Error) Is(target error) bool {
+	if t, ok := target.(*Error); ok {
+		return e.Matches(t.Domain, t.Code)
+	}
+	if e.Domain != IOErrorQuark() {
+		return false
+	}
+	switch IOErrorEnum(e.Code) {
+	case IO_ERROR_NOT_FOUND:
+		return target == os.ErrNotExist
+	case IO_ERROR_EXISTS:
+		return target == os.ErrExist
+	case IO_ERROR_PERMISSION_DENIED:
+		return target == os.ErrPermission
+	case IO_ERROR_CANCELLED:
+		return target == context.Canceled
+	}
+	return false
+}
+
+// TakeError converts and frees the GError at ptr. It returns nil if ptr
+// is nil. It is used by the packages wrapping libraries which report
+// GErrors.
+func TakeError(ptr unsafe.Pointer) error {
+	return takeError((*C.GError)(ptr))
+}
+
+// takeError converts and frees err.
+func takeError(err *C.GError) error {
+	if err == nil {
+		return nil
+	}
+	defer C.g_error_free(err)
+
+	return &Error{Quark(err.domain), int(err.code), goString(err.message)}
+}
+
+// newGError creates a GError from a Go error, to be handed over to GLib.
+// The domain and code of Errors, even wrapped, are kept, others are
+// reported as IO_ERROR_FAILED.
+func newGError(err error) *C.GError {
+	cstr := C.CString(err.Error())
+	defer C.free(unsafe.Pointer(cstr))
+
+	domain, code := C.g_io_error_quark(), C.gint(C.G_IO_ERROR_FAILED)
+	// Unwrap by hand, since errors.As needs Go 1.13.
+	for e := err; e != nil; {
+		if gerr, ok := e.(*Error); ok {
+			domain, code = C.GQuark(gerr.Domain), C.gint(gerr.Code)
+			break
+		}
+		wrapper, ok := e.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		e = wrapper.Unwrap()
+	}
+	return C.g_error_new_literal(domain, code, (*C.gchar)(cstr))
+}
+
+/*
+ * GIOErrorEnum
+ */
+
+// IOErrorQuark is a wrapper around g_io_error_quark().
+func IOErrorQuark() Quark {
+	return Quark(C.g_io_error_quark())
+}
+
+// IOErrorEnum is a representation of GIO's GIOErrorEnum, the codes of the
+// errors of the IOErrorQuark domain.
+type IOErrorEnum int
+
+const (
+	IO_ERROR_FAILED              IOErrorEnum = C.G_IO_ERROR_FAILED
+	IO_ERROR_NOT_FOUND           IOErrorEnum = C.G_IO_ERROR_NOT_FOUND
+	IO_ERROR_EXISTS              IOErrorEnum = C.G_IO_ERROR_EXISTS
+	IO_ERROR_IS_DIRECTORY        IOErrorEnum = C.G_IO_ERROR_IS_DIRECTORY
+	IO_ERROR_NOT_DIRECTORY       IOErrorEnum = C.G_IO_ERROR_NOT_DIRECTORY
+	IO_ERROR_NOT_EMPTY           IOErrorEnum = C.G_IO_ERROR_NOT_EMPTY
+	IO_ERROR_NOT_REGULAR_FILE    IOErrorEnum = C.G_IO_ERROR_NOT_REGULAR_FILE
+	IO_ERROR_NOT_SYMBOLIC_LINK   IOErrorEnum = C.G_IO_ERROR_NOT_SYMBOLIC_LINK
+	IO_ERROR_NOT_MOUNTABLE_FILE  IOErrorEnum = C.G_IO_ERROR_NOT_MOUNTABLE_FILE
+	IO_ERROR_FILENAME_TOO_LONG   IOErrorEnum = C.G_IO_ERROR_FILENAME_TOO_LONG
+	IO_ERROR_INVALID_FILENAME    IOErrorEnum = C.G_IO_ERROR_INVALID_FILENAME
+	IO_ERROR_TOO_MANY_LINKS      IOErrorEnum = C.G_IO_ERROR_TOO_MANY_LINKS
+	IO_ERROR_NO_SPACE            IOErrorEnum = C.G_IO_ERROR_NO_SPACE
+	IO_ERROR_INVALID_ARGUMENT    IOErrorEnum = C.G_IO_ERROR_INVALID_ARGUMENT
+	IO_ERROR_PERMISSION_DENIED   IOErrorEnum = C.G_IO_ERROR_PERMISSION_DENIED
+	IO_ERROR_NOT_SUPPORTED       IOErrorEnum = C.G_IO_ERROR_NOT_SUPPORTED
+	IO_ERROR_NOT_MOUNTED         IOErrorEnum = C.G_IO_ERROR_NOT_MOUNTED
+	IO_ERROR_ALREADY_MOUNTED     IOErrorEnum = C.G_IO_ERROR_ALREADY_MOUNTED
+	IO_ERROR_CLOSED              IOErrorEnum = C.G_IO_ERROR_CLOSED
+	IO_ERROR_CANCELLED           IOErrorEnum = C.G_IO_ERROR_CANCELLED
+	IO_ERROR_PENDING             IOErrorEnum = C.G_IO_ERROR_PENDING
+	IO_ERROR_READ_ONLY           IOErrorEnum = C.G_IO_ERROR_READ_ONLY
+	IO_ERROR_CANT_CREATE_BACKUP  IOErrorEnum = C.G_IO_ERROR_CANT_CREATE_BACKUP
+	IO_ERROR_WRONG_ETAG          IOErrorEnum = C.G_IO_ERROR_WRONG_ETAG
+	IO_ERROR_TIMED_OUT           IOErrorEnum = C.G_IO_ERROR_TIMED_OUT
+	IO_ERROR_WOULD_RECURSE       IOErrorEnum = C.G_IO_ERROR_WOULD_RECURSE
+	IO_ERROR_BUSY                IOErrorEnum = C.G_IO_ERROR_BUSY
+	IO_ERROR_WOULD_BLOCK         IOErrorEnum = C.G_IO_ERROR_WOULD_BLOCK
+	IO_ERROR_HOST_NOT_FOUND      IOErrorEnum = C.G_IO_ERROR_HOST_NOT_FOUND
+	IO_ERROR_WOULD_MERGE         IOErrorEnum = C.G_IO_ERROR_WOULD_MERGE
+	IO_ERROR_FAILED_HANDLED      IOErrorEnum = C.G_IO_ERROR_FAILED_HANDLED
+	IO_ERROR_TOO_MANY_OPEN_FILES IOErrorEnum = C.G_IO_ERROR_TOO_MANY_OPEN_FILES
+	IO_ERROR_NOT_INITIALIZED     IOErrorEnum = C.G_IO_ERROR_NOT_INITIALIZED
+	IO_ERROR_ADDRESS_IN_USE      IOErrorEnum = C.G_IO_ERROR_ADDRESS_IN_USE
+	IO_ERROR_PARTIAL_INPUT       IOErrorEnum = C.G_IO_ERROR_PARTIAL_INPUT
+	IO_ERROR_INVALID_DATA        IOErrorEnum = C.G_IO_ERROR_INVALID_DATA
+	IO_ERROR_DBUS_ERROR          IOErrorEnum = C.G_IO_ERROR_DBUS_ERROR
+	IO_ERROR_HOST_UNREACHABLE    IOErrorEnum = C.G_IO_ERROR_HOST_UNREACHABLE
+	IO_ERROR_NETWORK_UNREACHABLE IOErrorEnum = C.G_IO_ERROR_NETWORK_UNREACHABLE
+	IO_ERROR_CONNECTION_REFUSED  IOErrorEnum = C.G_IO_ERROR_CONNECTION_REFUSED
+	IO_ERROR_PROXY_FAILED        IOErrorEnum = C.G_IO_ERROR_PROXY_FAILED
+	IO_ERROR_PROXY_AUTH_FAILED   IOErrorEnum = C.G_IO_ERROR_PROXY_AUTH_FAILED
+	IO_ERROR_PROXY_NEED_AUTH     IOErrorEnum = C.G_IO_ERROR_PROXY_NEED_AUTH
+	IO_ERROR_PROXY_NOT_ALLOWED   IOErrorEnum = C.G_IO_ERROR_PROXY_NOT_ALLOWED
+	IO_ERROR_BROKEN_PIPE         IOErrorEnum = C.G_IO_ERROR_BROKEN_PIPE
+	IO_ERROR_CONNECTION_CLOSED   IOErrorEnum = C.G_IO_ERROR_CONNECTION_CLOSED
+)
+
+// Errors of the IOErrorQuark domain, to be matched with errors.Is.
+var (
+	ErrIOFailed            = NewError(IOErrorQuark(), int(IO_ERROR_FAILED), "operation failed")
+	ErrIONotFound          = NewError(IOErrorQuark(), int(IO_ERROR_NOT_FOUND), "file not found")
+	ErrIOExists            = NewError(IOErrorQuark(), int(IO_ERROR_EXISTS), "file already exists")
+	ErrIOIsDirectory       = NewError(IOErrorQuark(), int(IO_ERROR_IS_DIRECTORY), "file is a directory")
+	ErrIONotDirectory      = NewError(IOErrorQuark(), int(IO_ERROR_NOT_DIRECTORY), "file is not a directory")
+	ErrIONotEmpty          = NewError(IOErrorQuark(), int(IO_ERROR_NOT_EMPTY), "directory not empty")
+	ErrIOInvalidArgument   = NewError(IOErrorQuark(), int(IO_ERROR_INVALID_ARGUMENT), "invalid argument")
+	ErrIOPermissionDenied  = NewError(IOErrorQuark(), int(IO_ERROR_PERMISSION_DENIED), "permission denied")
+	ErrIONotSupported      = NewError(IOErrorQuark(), int(IO_ERROR_NOT_SUPPORTED), "operation not supported")
+	ErrIOClosed            = NewError(IOErrorQuark(), int(IO_ERROR_CLOSED), "file or stream already closed")
+	ErrIOCancelled         = NewError(IOErrorQuark(), int(IO_ERROR_CANCELLED), "operation was cancelled")
+	ErrIOPending           = NewError(IOErrorQuark(), int(IO_ERROR_PENDING), "operations are still pending")
+	ErrIOReadOnly          = NewError(IOErrorQuark(), int(IO_ERROR_READ_ONLY), "file is read-only")
+	ErrIOTimedOut          = NewError(IOErrorQuark(), int(IO_ERROR_TIMED_OUT), "operation timed out")
+	ErrIOBusy              = NewError(IOErrorQuark(), int(IO_ERROR_BUSY), "file is busy")
+	ErrIOWouldBlock        = NewError(IOErrorQuark(), int(IO_ERROR_WOULD_BLOCK), "operation would block")
+	ErrIOHostNotFound      = NewError(IOErrorQuark(), int(IO_ERROR_HOST_NOT_FOUND), "host not found")
+	ErrIOPartialInput      = NewError(IOErrorQuark(), int(IO_ERROR_PARTIAL_INPUT), "need more input")
+	ErrIOInvalidData       = NewError(IOErrorQuark(), int(IO_ERROR_INVALID_DATA), "input data is invalid")
+	ErrIOConnectionRefused = NewError(IOErrorQuark(), int(IO_ERROR_CONNECTION_REFUSED), "connection refused")
+	ErrIOBrokenPipe        = NewError(IOErrorQuark(), int(IO_ERROR_BROKEN_PIPE), "broken pipe")
+)
@@ -0,0 +1,40 @@
+package glib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestError_Is(t *testing.T) {
+	file, err := glib.FileForPathNew(filepath.Join(t.TempDir(), "missing"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = file.Read(nil)
+	if !errors.Is(err, glib.ErrIONotFound) {
+		t.Errorf("Expected a not found error, got %v", err)
+	}
+	if errors.Is(err, glib.ErrIOCancelled) {
+		t.Error("Expected a not found error not to match a cancelled error")
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Error("Expected a not found error to match os.ErrNotExist")
+	}
+
+	var gerr *glib.Error
+	if !errors.As(err, &gerr) {
+		t.Fatalf("Expected a *glib.Error, got %T", err)
+	}
+	if gerr.Domain != glib.IOErrorQuark() || gerr.Code != int(glib.IO_ERROR_NOT_FOUND) {
+		t.Errorf("Expected domain %s and code %d, got %s and %d",
+			glib.IOErrorQuark(), glib.IO_ERROR_NOT_FOUND, gerr.Domain, gerr.Code)
+	}
+	if domain := gerr.Domain.String(); domain != "g-io-error-quark" {
+		t.Errorf("Expected domain %q, got %q", "g-io-error-quark", domain)
+	}
+}
@@ -0,0 +1,701 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"time"
+	"unsafe"
+)
+
+// Common attributes of GFileInfo, to be used with File.QueryInfo and
+// FileInfo attribute getters and setters. Several attributes can be
+// joined with commas, "*" matches all attributes and "standard::*"
+// all attributes of the standard namespace.
+const (
+	FILE_ATTRIBUTE_STANDARD_TYPE              = "standard::type"
+	FILE_ATTRIBUTE_STANDARD_IS_HIDDEN         = "standard::is-hidden"
+	FILE_ATTRIBUTE_STANDARD_IS_BACKUP         = "standard::is-backup"
+	FILE_ATTRIBUTE_STANDARD_IS_SYMLINK        = "standard::is-symlink"
+	FILE_ATTRIBUTE_STANDARD_IS_VIRTUAL        = "standard::is-virtual"
+	FILE_ATTRIBUTE_STANDARD_NAME              = "standard::name"
+	FILE_ATTRIBUTE_STANDARD_DISPLAY_NAME      = "standard::display-name"
+	FILE_ATTRIBUTE_STANDARD_EDIT_NAME         = "standard::edit-name"
+	FILE_ATTRIBUTE_STANDARD_COPY_NAME         = "standard::copy-name"
+	FILE_ATTRIBUTE_STANDARD_ICON              = "standard::icon"
+	FILE_ATTRIBUTE_STANDARD_SYMBOLIC_ICON     = "standard::symbolic-icon"
+	FILE_ATTRIBUTE_STANDARD_CONTENT_TYPE      = "standard::content-type"
+	FILE_ATTRIBUTE_STANDARD_FAST_CONTENT_TYPE = "standard::fast-content-type"
+	FILE_ATTRIBUTE_STANDARD_SIZE              = "standard::size"
+	FILE_ATTRIBUTE_STANDARD_ALLOCATED_SIZE    = "standard::allocated-size"
+	FILE_ATTRIBUTE_STANDARD_SYMLINK_TARGET    = "standard::symlink-target"
+	FILE_ATTRIBUTE_STANDARD_TARGET_URI        = "standard::target-uri"
+	FILE_ATTRIBUTE_STANDARD_SORT_ORDER        = "standard::sort-order"
+	FILE_ATTRIBUTE_ETAG_VALUE                 = "etag::value"
+	FILE_ATTRIBUTE_ACCESS_CAN_READ            = "access::can-read"
+	FILE_ATTRIBUTE_ACCESS_CAN_WRITE           = "access::can-write"
+	FILE_ATTRIBUTE_ACCESS_CAN_EXECUTE         = "access::can-execute"
+	FILE_ATTRIBUTE_ACCESS_CAN_DELETE          = "access::can-delete"
+	FILE_ATTRIBUTE_ACCESS_CAN_TRASH           = "access::can-trash"
+	FILE_ATTRIBUTE_ACCESS_CAN_RENAME          = "access::can-rename"
+	FILE_ATTRIBUTE_TIME_MODIFIED              = "time::modified"
+	FILE_ATTRIBUTE_TIME_MODIFIED_USEC         = "time::modified-usec"
+	FILE_ATTRIBUTE_TIME_ACCESS                = "time::access"
+	FILE_ATTRIBUTE_TIME_ACCESS_USEC           = "time::access-usec"
+	FILE_ATTRIBUTE_TIME_CHANGED               = "time::changed"
+	FILE_ATTRIBUTE_TIME_CHANGED_USEC          = "time::changed-usec"
+	FILE_ATTRIBUTE_TIME_CREATED               = "time::created"
+	FILE_ATTRIBUTE_TIME_CREATED_USEC          = "time::created-usec"
+	FILE_ATTRIBUTE_UNIX_MODE                  = "unix::mode"
+	FILE_ATTRIBUTE_UNIX_UID                   = "unix::uid"
+	FILE_ATTRIBUTE_UNIX_GID                   = "unix::gid"
+	FILE_ATTRIBUTE_OWNER_USER                 = "owner::user"
+	FILE_ATTRIBUTE_OWNER_GROUP                = "owner::group"
+	FILE_ATTRIBUTE_THUMBNAIL_PATH             = "thumbnail::path"
+	FILE_ATTRIBUTE_FILESYSTEM_SIZE            = "filesystem::size"
+	FILE_ATTRIBUTE_FILESYSTEM_FREE            = "filesystem::free"
+	FILE_ATTRIBUTE_FILESYSTEM_TYPE            = "filesystem::type"
+	FILE_ATTRIBUTE_FILESYSTEM_READONLY        = "filesystem::readonly"
+	FILE_ATTRIBUTE_TRASH_ITEM_COUNT           = "trash::item-count"
+)
+
+// FileAttributeType is a representation of GLib's GFileAttributeType.
+type FileAttributeType int
+
+const (
+	FILE_ATTRIBUTE_TYPE_INVALID     FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_INVALID
+	FILE_ATTRIBUTE_TYPE_STRING      FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_STRING
+	FILE_ATTRIBUTE_TYPE_BYTE_STRING FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_BYTE_STRING
+	FILE_ATTRIBUTE_TYPE_BOOLEAN     FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_BOOLEAN
+	FILE_ATTRIBUTE_TYPE_UINT32      FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_UINT32
+	FILE_ATTRIBUTE_TYPE_INT32       FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_INT32
+	FILE_ATTRIBUTE_TYPE_UINT64      FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_UINT64
+	FILE_ATTRIBUTE_TYPE_INT64       FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_INT64
+	FILE_ATTRIBUTE_TYPE_OBJECT      FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_OBJECT
+	FILE_ATTRIBUTE_TYPE_STRINGV     FileAttributeType = C.G_FILE_ATTRIBUTE_TYPE_STRINGV
+)
+
+// FileAttributeStatus is a representation of GLib's GFileAttributeStatus.
+type FileAttributeStatus int
+
+const (
+	FILE_ATTRIBUTE_STATUS_UNSET         FileAttributeStatus = C.G_FILE_ATTRIBUTE_STATUS_UNSET
+	FILE_ATTRIBUTE_STATUS_SET           FileAttributeStatus = C.G_FILE_ATTRIBUTE_STATUS_SET
+	FILE_ATTRIBUTE_STATUS_ERROR_SETTING FileAttributeStatus = C.G_FILE_ATTRIBUTE_STATUS_ERROR_SETTING
+)
+
+// FileInfoNew is a wrapper around g_file_info_new().
+func FileInfoNew() (*FileInfo, error) {
+	c := C.g_file_info_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+// Dup is a wrapper around g_file_info_dup().
+func (v *FileInfo) Dup() (*FileInfo, error) {
+	c := C.g_file_info_dup(v.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+// CopyInto is a wrapper around g_file_info_copy_into().
+func (v *FileInfo) CopyInto(destInfo *FileInfo) {
+	C.g_file_info_copy_into(v.native(), destInfo.native())
+}
+
+// HasAttribute is a wrapper around g_file_info_has_attribute().
+func (v *FileInfo) HasAttribute(attribute string) bool {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_info_has_attribute(v.native(), cstr))
+}
+
+// HasNamespace is a wrapper around g_file_info_has_namespace().
+func (v *FileInfo) HasNamespace(nameSpace string) bool {
+	cstr := C.CString(nameSpace)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_info_has_namespace(v.native(), cstr))
+}
+
+// ListAttributes is a wrapper around g_file_info_list_attributes().
+// If nameSpace is empty, all attributes are listed.
+func (v *FileInfo) ListAttributes(nameSpace string) []string {
+	var cstr *C.char
+	if nameSpace != "" {
+		cstr = C.CString(nameSpace)
+		defer C.free(unsafe.Pointer(cstr))
+	}
+
+	c := C.g_file_info_list_attributes(v.native(), cstr)
+	if c == nil {
+		return nil
+	}
+	// both pointer array and strings should be freed.
+	defer C.g_strfreev((**C.gchar)(unsafe.Pointer(c)))
+
+	return goStringArray((**C.gchar)(unsafe.Pointer(c)))
+}
+
+// GetAttributeType is a wrapper around g_file_info_get_attribute_type().
+func (v *FileInfo) GetAttributeType(attribute string) FileAttributeType {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return FileAttributeType(C.g_file_info_get_attribute_type(v.native(), cstr))
+}
+
+// RemoveAttribute is a wrapper around g_file_info_remove_attribute().
+func (v *FileInfo) RemoveAttribute(attribute string) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_remove_attribute(v.native(), cstr)
+}
+
+// GetAttributeAsString is a wrapper around g_file_info_get_attribute_as_string().
+// A non-nil error is returned if the attribute is not set.
+func (v *FileInfo) GetAttributeAsString(attribute string) (string, error) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_info_get_attribute_as_string(v.native(), cstr)
+	if c == nil {
+		return "", errNilPtr
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c), nil
+}
+
+// GetAttributeStatus is a wrapper around g_file_info_get_attribute_status().
+func (v *FileInfo) GetAttributeStatus(attribute string) FileAttributeStatus {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return FileAttributeStatus(C.g_file_info_get_attribute_status(v.native(), cstr))
+}
+
+// SetAttributeStatus is a wrapper around g_file_info_set_attribute_status().
+func (v *FileInfo) SetAttributeStatus(attribute string, status FileAttributeStatus) bool {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_info_set_attribute_status(v.native(), cstr,
+		C.GFileAttributeStatus(status)))
+}
+
+// GetAttributeString is a wrapper around g_file_info_get_attribute_string().
+func (v *FileInfo) GetAttributeString(attribute string) string {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_info_get_attribute_string(v.native(), cstr)
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetAttributeByteString is a wrapper around g_file_info_get_attribute_byte_string().
+func (v *FileInfo) GetAttributeByteString(attribute string) string {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_info_get_attribute_byte_string(v.native(), cstr)
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetAttributeStringv is a wrapper around g_file_info_get_attribute_stringv().
+func (v *FileInfo) GetAttributeStringv(attribute string) []string {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_info_get_attribute_stringv(v.native(), cstr)
+	if c == nil {
+		return nil
+	}
+	// owned by the info, do not free.
+	return goStringArray((**C.gchar)(unsafe.Pointer(c)))
+}
+
+// GetAttributeBoolean is a wrapper around g_file_info_get_attribute_boolean().
+func (v *FileInfo) GetAttributeBoolean(attribute string) bool {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_file_info_get_attribute_boolean(v.native(), cstr))
+}
+
+// GetAttributeUint32 is a wrapper around g_file_info_get_attribute_uint32().
+func (v *FileInfo) GetAttributeUint32(attribute string) uint32 {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return uint32(C.g_file_info_get_attribute_uint32(v.native(), cstr))
+}
+
+// GetAttributeInt32 is a wrapper around g_file_info_get_attribute_int32().
+func (v *FileInfo) GetAttributeInt32(attribute string) int32 {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return int32(C.g_file_info_get_attribute_int32(v.native(), cstr))
+}
+
+// GetAttributeUint64 is a wrapper around g_file_info_get_attribute_uint64().
+func (v *FileInfo) GetAttributeUint64(attribute string) uint64 {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return uint64(C.g_file_info_get_attribute_uint64(v.native(), cstr))
+}
+
+// GetAttributeInt64 is a wrapper around g_file_info_get_attribute_int64().
+func (v *FileInfo) GetAttributeInt64(attribute string) int64 {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return int64(C.g_file_info_get_attribute_int64(v.native(), cstr))
+}
+
+// GetAttributeObject is a wrapper around g_file_info_get_attribute_object().
+// nil is returned if the attribute is not set or does not hold an object.
+func (v *FileInfo) GetAttributeObject(attribute string) *Object {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_file_info_get_attribute_object(v.native(), cstr)
+	if c == nil {
+		return nil
+	}
+	return wrapObject(unsafe.Pointer(c))
+}
+
+// SetAttributeString is a wrapper around g_file_info_set_attribute_string().
+func (v *FileInfo) SetAttributeString(attribute, value string) {
+	cstr1 := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_file_info_set_attribute_string(v.native(), cstr1, cstr2)
+}
+
+// SetAttributeByteString is a wrapper around g_file_info_set_attribute_byte_string().
+func (v *FileInfo) SetAttributeByteString(attribute, value string) {
+	cstr1 := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_file_info_set_attribute_byte_string(v.native(), cstr1, cstr2)
+}
+
+// SetAttributeStringv is a wrapper around g_file_info_set_attribute_stringv().
+func (v *FileInfo) SetAttributeStringv(attribute string, value []string) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	cvalue := C.make_strings(C.int(len(value) + 1))
+	defer C.destroy_strings(cvalue)
+
+	for i, str := range value {
+		cval := C.CString(str)
+		defer C.free(unsafe.Pointer(cval))
+		C.set_string(cvalue, C.int(i), cval)
+	}
+	C.set_string(cvalue, C.int(len(value)), nil)
+
+	C.g_file_info_set_attribute_stringv(v.native(), cstr, cvalue)
+}
+
+// SetAttributeBoolean is a wrapper around g_file_info_set_attribute_boolean().
+func (v *FileInfo) SetAttributeBoolean(attribute string, value bool) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_attribute_boolean(v.native(), cstr, gbool(value))
+}
+
+// SetAttributeUint32 is a wrapper around g_file_info_set_attribute_uint32().
+func (v *FileInfo) SetAttributeUint32(attribute string, value uint32) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_attribute_uint32(v.native(), cstr, C.guint32(value))
+}
+
+// SetAttributeInt32 is a wrapper around g_file_info_set_attribute_int32().
+func (v *FileInfo) SetAttributeInt32(attribute string, value int32) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_attribute_int32(v.native(), cstr, C.gint32(value))
+}
+
+// SetAttributeUint64 is a wrapper around g_file_info_set_attribute_uint64().
+func (v *FileInfo) SetAttributeUint64(attribute string, value uint64) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_attribute_uint64(v.native(), cstr, C.guint64(value))
+}
+
+// SetAttributeInt64 is a wrapper around g_file_info_set_attribute_int64().
+func (v *FileInfo) SetAttributeInt64(attribute string, value int64) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_attribute_int64(v.native(), cstr, C.gint64(value))
+}
+
+// SetAttributeObject is a wrapper around g_file_info_set_attribute_object().
+func (v *FileInfo) SetAttributeObject(attribute string, value IObject) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_attribute_object(v.native(), cstr, value.toObject().native())
+}
+
+// ClearStatus is a wrapper around g_file_info_clear_status().
+func (v *FileInfo) ClearStatus() {
+	C.g_file_info_clear_status(v.native())
+}
+
+// GetName is a wrapper around g_file_info_get_name().
+func (v *FileInfo) GetName() string {
+	c := C.g_file_info_get_name(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// SetName is a wrapper around g_file_info_set_name().
+func (v *FileInfo) SetName(name string) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_name(v.native(), cstr)
+}
+
+// GetDisplayName is a wrapper around g_file_info_get_display_name().
+func (v *FileInfo) GetDisplayName() string {
+	c := C.g_file_info_get_display_name(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// SetDisplayName is a wrapper around g_file_info_set_display_name().
+func (v *FileInfo) SetDisplayName(displayName string) {
+	cstr := C.CString(displayName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_display_name(v.native(), cstr)
+}
+
+// GetEditName is a wrapper around g_file_info_get_edit_name().
+func (v *FileInfo) GetEditName() string {
+	c := C.g_file_info_get_edit_name(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetFileType is a wrapper around g_file_info_get_file_type().
+func (v *FileInfo) GetFileType() FileType {
+	return FileType(C.g_file_info_get_file_type(v.native()))
+}
+
+// SetFileType is a wrapper around g_file_info_set_file_type().
+func (v *FileInfo) SetFileType(fileType FileType) {
+	C.g_file_info_set_file_type(v.native(), C.GFileType(fileType))
+}
+
+// GetSize is a wrapper around g_file_info_get_size().
+func (v *FileInfo) GetSize() int64 {
+	return int64(C.g_file_info_get_size(v.native()))
+}
+
+// SetSize is a wrapper around g_file_info_set_size().
+func (v *FileInfo) SetSize(size int64) {
+	C.g_file_info_set_size(v.native(), C.goffset(size))
+}
+
+// GetContentType is a wrapper around g_file_info_get_content_type().
+func (v *FileInfo) GetContentType() string {
+	c := C.g_file_info_get_content_type(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// SetContentType is a wrapper around g_file_info_set_content_type().
+func (v *FileInfo) SetContentType(contentType string) {
+	cstr := C.CString(contentType)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_content_type(v.native(), cstr)
+}
+
+// GetIsHidden is a wrapper around g_file_info_get_is_hidden().
+func (v *FileInfo) GetIsHidden() bool {
+	return gobool(C.g_file_info_get_is_hidden(v.native()))
+}
+
+// SetIsHidden is a wrapper around g_file_info_set_is_hidden().
+func (v *FileInfo) SetIsHidden(isHidden bool) {
+	C.g_file_info_set_is_hidden(v.native(), gbool(isHidden))
+}
+
+// GetIsBackup is a wrapper around g_file_info_get_is_backup().
+func (v *FileInfo) GetIsBackup() bool {
+	return gobool(C.g_file_info_get_is_backup(v.native()))
+}
+
+// GetIsSymlink is a wrapper around g_file_info_get_is_symlink().
+func (v *FileInfo) GetIsSymlink() bool {
+	return gobool(C.g_file_info_get_is_symlink(v.native()))
+}
+
+// SetIsSymlink is a wrapper around g_file_info_set_is_symlink().
+func (v *FileInfo) SetIsSymlink(isSymlink bool) {
+	C.g_file_info_set_is_symlink(v.native(), gbool(isSymlink))
+}
+
+// GetSymlinkTarget is a wrapper around g_file_info_get_symlink_target().
+func (v *FileInfo) GetSymlinkTarget() string {
+	c := C.g_file_info_get_symlink_target(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// SetSymlinkTarget is a wrapper around g_file_info_set_symlink_target().
+func (v *FileInfo) SetSymlinkTarget(symlinkTarget string) {
+	cstr := C.CString(symlinkTarget)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_info_set_symlink_target(v.native(), cstr)
+}
+
+// GetEtag is a wrapper around g_file_info_get_etag().
+func (v *FileInfo) GetEtag() string {
+	c := C.g_file_info_get_etag(v.native())
+	if c == nil {
+		return ""
+	}
+	return C.GoString(c)
+}
+
+// GetSortOrder is a wrapper around g_file_info_get_sort_order().
+func (v *FileInfo) GetSortOrder() int {
+	return int(C.g_file_info_get_sort_order(v.native()))
+}
+
+// SetSortOrder is a wrapper around g_file_info_set_sort_order().
+func (v *FileInfo) SetSortOrder(sortOrder int) {
+	C.g_file_info_set_sort_order(v.native(), C.gint32(sortOrder))
+}
+
+// GetIcon is a wrapper around g_file_info_get_icon().
+func (v *FileInfo) GetIcon() *Icon {
+	c := C.g_file_info_get_icon(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapIcon(*InterfaceFromObjectNew(obj))
+}
+
+// GetSymbolicIcon is a wrapper around g_file_info_get_symbolic_icon().
+func (v *FileInfo) GetSymbolicIcon() *Icon {
+	c := C.g_file_info_get_symbolic_icon(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapIcon(*InterfaceFromObjectNew(obj))
+}
+
+// GetModificationTime returns the modification time of the file, based
+// on the FILE_ATTRIBUTE_TIME_MODIFIED and FILE_ATTRIBUTE_TIME_MODIFIED_USEC
+// attributes, like g_file_info_get_modification_time() does. A non-nil
+// error is returned if the info does not hold the modification time.
+func (v *FileInfo) GetModificationTime() (time.Time, error) {
+	if !v.HasAttribute(FILE_ATTRIBUTE_TIME_MODIFIED) {
+		return time.Time{}, errors.New("modification time is not set")
+	}
+	sec := v.GetAttributeUint64(FILE_ATTRIBUTE_TIME_MODIFIED)
+	usec := v.GetAttributeUint32(FILE_ATTRIBUTE_TIME_MODIFIED_USEC)
+	return time.Unix(int64(sec), int64(usec)*int64(time.Microsecond)), nil
+}
+
+// SetModificationTime sets the FILE_ATTRIBUTE_TIME_MODIFIED and
+// FILE_ATTRIBUTE_TIME_MODIFIED_USEC attributes, like
+// g_file_info_set_modification_time() does.
+func (v *FileInfo) SetModificationTime(t time.Time) {
+	v.SetAttributeUint64(FILE_ATTRIBUTE_TIME_MODIFIED, uint64(t.Unix()))
+	v.SetAttributeUint32(FILE_ATTRIBUTE_TIME_MODIFIED_USEC,
+		uint32(t.Nanosecond()/int(time.Microsecond)))
+}
+
+/*
+ * GFile attributes
+ */
+
+// gboolean	g_file_set_attributes_from_info ()
+func (v *File) SetAttributesFromInfo(info *FileInfo, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_file_set_attributes_from_info(v.native(), info.native(),
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// void	g_file_set_attributes_async ()
+func (v *File) SetAttributesAsync(info *FileInfo, flags FileQueryInfoFlags, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_set_attributes_async(v.native(), info.native(), C.GFileQueryInfoFlags(flags),
+		C.int(ioPriority), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// gboolean	g_file_set_attributes_finish ()
+// The returned FileInfo holds the status of every attribute,
+// to find out which ones failed to be set.
+func (v *File) SetAttributesFinish(result *AsyncResult) (*FileInfo, error) {
+	var err *C.GError
+	var info *C.GFileInfo
+	c := C.g_file_set_attributes_finish(v.native(), result.native(), &info, &err)
+	var info2 *FileInfo
+	if info != nil {
+		info2 = wrapFileInfo(Take(unsafe.Pointer(info)))
+	}
+	if c == 0 {
+		return info2, takeError(err)
+	}
+	return info2, nil
+}
+
+// gboolean	g_file_set_attribute_string ()
+func (v *File) SetAttributeString(attribute, value string, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	cstr1 := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_file_set_attribute_string(v.native(), cstr1, cstr2,
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// gboolean	g_file_set_attribute_byte_string ()
+func (v *File) SetAttributeByteString(attribute, value string, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	cstr1 := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_file_set_attribute_byte_string(v.native(), cstr1, cstr2,
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// gboolean	g_file_set_attribute_uint32 ()
+func (v *File) SetAttributeUint32(attribute string, value uint32, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_file_set_attribute_uint32(v.native(), cstr, C.guint32(value),
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// gboolean	g_file_set_attribute_int32 ()
+func (v *File) SetAttributeInt32(attribute string, value int32, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_file_set_attribute_int32(v.native(), cstr, C.gint32(value),
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// gboolean	g_file_set_attribute_uint64 ()
+func (v *File) SetAttributeUint64(attribute string, value uint64, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_file_set_attribute_uint64(v.native(), cstr, C.guint64(value),
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// gboolean	g_file_set_attribute_int64 ()
+func (v *File) SetAttributeInt64(attribute string, value int64, flags FileQueryInfoFlags,
+	cancel *Cancellable) error {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_file_set_attribute_int64(v.native(), cstr, C.gint64(value),
+		C.GFileQueryInfoFlags(flags), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
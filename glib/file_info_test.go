@@ -0,0 +1,81 @@
+package glib_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestFileInfoAttributes(t *testing.T) {
+	info, err := glib.FileInfoNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info.SetName("notes.txt")
+	info.SetFileType(glib.FILE_TYPE_REGULAR)
+	info.SetSize(1234)
+	info.SetIsHidden(true)
+	info.SetAttributeStringv("xattr::tags", []string{"work", "draft"})
+	modified := time.Unix(1500000000, 250000*int64(time.Microsecond))
+	info.SetModificationTime(modified)
+
+	if name := info.GetName(); name != "notes.txt" {
+		t.Errorf("Expected the name %q, got %q", "notes.txt", name)
+	}
+	if info.GetFileType() != glib.FILE_TYPE_REGULAR || info.GetSize() != 1234 || !info.GetIsHidden() {
+		t.Error("Expected the type, size and hidden flag to be set")
+	}
+	if tags := info.GetAttributeStringv("xattr::tags"); len(tags) != 2 || tags[1] != "draft" {
+		t.Errorf("Expected the tags, got %v", tags)
+	}
+	if typ := info.GetAttributeType(glib.FILE_ATTRIBUTE_STANDARD_SIZE); typ != glib.FILE_ATTRIBUTE_TYPE_UINT64 {
+		t.Errorf("Expected the size to be a uint64, got %v", typ)
+	}
+	if got, err := info.GetModificationTime(); err != nil || !got.Equal(modified) {
+		t.Errorf("Expected %v, got %v (%v)", modified, got, err)
+	}
+
+	info.RemoveAttribute(glib.FILE_ATTRIBUTE_TIME_MODIFIED)
+	if _, err := info.GetModificationTime(); err == nil {
+		t.Error("Expected an error once the modification time is removed")
+	}
+	if attrs := info.ListAttributes("standard"); len(attrs) != 4 {
+		t.Errorf("Expected 4 standard attributes, got %v", attrs)
+	}
+}
+
+func TestFileSetAttributes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(path, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := glib.FileForPathNew(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = file.SetAttributeUint64(glib.FILE_ATTRIBUTE_TIME_MODIFIED, 1500000000,
+		glib.FILE_QUERY_INFO_NONE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = file.SetAttributeUint32(glib.FILE_ATTRIBUTE_UNIX_MODE, 0640,
+		glib.FILE_QUERY_INFO_NONE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.ModTime().Unix() != 1500000000 {
+		t.Errorf("Expected the modification time to be set, got %v", st.ModTime())
+	}
+	if st.Mode().Perm() != 0640 {
+		t.Errorf("Expected mode 0640, got %v", st.Mode().Perm())
+	}
+}
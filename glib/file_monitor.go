@@ -0,0 +1,179 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+// FileMonitorFlags is a representation of GLib's GFileMonitorFlags.
+type FileMonitorFlags int
+
+const (
+	FILE_MONITOR_NONE             FileMonitorFlags = C.G_FILE_MONITOR_NONE
+	FILE_MONITOR_WATCH_MOUNTS     FileMonitorFlags = C.G_FILE_MONITOR_WATCH_MOUNTS
+	FILE_MONITOR_SEND_MOVED       FileMonitorFlags = C.G_FILE_MONITOR_SEND_MOVED
+	FILE_MONITOR_WATCH_HARD_LINKS FileMonitorFlags = C.G_FILE_MONITOR_WATCH_HARD_LINKS
+)
+
+// FileMonitorEvent is a representation of GLib's GFileMonitorEvent.
+type FileMonitorEvent int
+
+const (
+	FILE_MONITOR_EVENT_CHANGED           FileMonitorEvent = C.G_FILE_MONITOR_EVENT_CHANGED
+	FILE_MONITOR_EVENT_CHANGES_DONE_HINT FileMonitorEvent = C.G_FILE_MONITOR_EVENT_CHANGES_DONE_HINT
+	FILE_MONITOR_EVENT_DELETED           FileMonitorEvent = C.G_FILE_MONITOR_EVENT_DELETED
+	FILE_MONITOR_EVENT_CREATED           FileMonitorEvent = C.G_FILE_MONITOR_EVENT_CREATED
+	FILE_MONITOR_EVENT_ATTRIBUTE_CHANGED FileMonitorEvent = C.G_FILE_MONITOR_EVENT_ATTRIBUTE_CHANGED
+	FILE_MONITOR_EVENT_PRE_UNMOUNT       FileMonitorEvent = C.G_FILE_MONITOR_EVENT_PRE_UNMOUNT
+	FILE_MONITOR_EVENT_UNMOUNTED         FileMonitorEvent = C.G_FILE_MONITOR_EVENT_UNMOUNTED
+	FILE_MONITOR_EVENT_MOVED             FileMonitorEvent = C.G_FILE_MONITOR_EVENT_MOVED
+)
+
+func marshalFileMonitorEvent(p uintptr) (interface{}, error) {
+	c := C.g_value_get_enum((*C.GValue)(unsafe.Pointer(p)))
+	return FileMonitorEvent(c), nil
+}
+
+/*
+ * GFileMonitor
+ */
+
+// FileMonitor is a representation of GFileMonitor.
+//
+// Events are delivered by the "changed" signal, from the thread-default
+// main context in use when the monitor was created, which is the GTK+
+// main loop for monitors created from the main thread.
+type FileMonitor struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GFileMonitor.
+func (v *FileMonitor) native() *C.GFileMonitor {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGFileMonitor(ptr)
+}
+
+func marshalFileMonitor(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileMonitor(obj), nil
+}
+
+func wrapFileMonitor(obj *Object) *FileMonitor {
+	return &FileMonitor{obj}
+}
+
+// Cancel is a wrapper around g_file_monitor_cancel().
+func (v *FileMonitor) Cancel() bool {
+	return gobool(C.g_file_monitor_cancel(v.native()))
+}
+
+// IsCancelled is a wrapper around g_file_monitor_is_cancelled().
+func (v *FileMonitor) IsCancelled() bool {
+	return gobool(C.g_file_monitor_is_cancelled(v.native()))
+}
+
+// SetRateLimit is a wrapper around g_file_monitor_set_rate_limit().
+// limitMsecs is the minimum delay between two "changed" events
+// reported for the same file.
+func (v *FileMonitor) SetRateLimit(limitMsecs int) {
+	C.g_file_monitor_set_rate_limit(v.native(), C.gint(limitMsecs))
+}
+
+// ConnectChanged connects f to the "changed" signal of the monitor.
+// otherFile is nil unless event involves two files, as moves do.
+func (v *FileMonitor) ConnectChanged(f func(file, otherFile *File,
+	event FileMonitorEvent)) (SignalHandle, error) {
+	// The instance is a private subclass of GFileMonitor,
+	// which is not marshaled as a FileMonitor.
+	return v.Connect("changed", func(_ interface{}, file, otherFile *File,
+		event FileMonitorEvent) {
+		f(file, otherFile, event)
+	})
+}
+
+// FileMonitorChange holds the arguments of a "changed" signal
+// of a FileMonitor.
+type FileMonitorChange struct {
+	File      *File
+	OtherFile *File
+	Event     FileMonitorEvent
+}
+
+// ChangedChan returns a channel which receives the "changed" events of
+// the monitor, for code which handles them out of the main loop. Since
+// the signal is emitted by the main loop, events are dropped rather than
+// blocking it whenever the channel already holds bufferSize events.
+// The channel is never closed; disconnect the returned handle to stop
+// receiving events.
+func (v *FileMonitor) ChangedChan(bufferSize int) (<-chan FileMonitorChange, SignalHandle, error) {
+	ch := make(chan FileMonitorChange, bufferSize)
+	handle, err := v.ConnectChanged(func(file, otherFile *File, event FileMonitorEvent) {
+		select {
+		case ch <- FileMonitorChange{file, otherFile, event}:
+		default:
+		}
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return ch, handle, nil
+}
+
+/*
+ * GFile monitoring
+ */
+
+// GFileMonitor *	g_file_monitor_directory ()
+func (v *File) MonitorDirectory(flags FileMonitorFlags, cancel *Cancellable) (*FileMonitor, error) {
+	var err *C.GError
+	c := C.g_file_monitor_directory(v.native(), C.GFileMonitorFlags(flags), cancel.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileMonitor(obj), nil
+}
+
+// GFileMonitor *	g_file_monitor_file ()
+func (v *File) MonitorFile(flags FileMonitorFlags, cancel *Cancellable) (*FileMonitor, error) {
+	var err *C.GError
+	c := C.g_file_monitor_file(v.native(), C.GFileMonitorFlags(flags), cancel.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileMonitor(obj), nil
+}
+
+// GFileMonitor *	g_file_monitor ()
+// Depending on the type of the file, it either monitors
+// a directory or a single file.
+func (v *File) Monitor(flags FileMonitorFlags, cancel *Cancellable) (*FileMonitor, error) {
+	var err *C.GError
+	c := C.g_file_monitor(v.native(), C.GFileMonitorFlags(flags), cancel.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileMonitor(obj), nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_file_get_type()), marshalFile},
+		{Type(C.g_file_monitor_get_type()), marshalFileMonitor},
+		{Type(C.g_file_monitor_event_get_type()), marshalFileMonitorEvent},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,22 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44
+
+// See: https://developer.gnome.org/glib/2.46/api-index-2-46.html
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+
+const (
+	FILE_MONITOR_WATCH_MOVES FileMonitorFlags = C.G_FILE_MONITOR_WATCH_MOVES
+)
+
+const (
+	FILE_MONITOR_EVENT_RENAMED   FileMonitorEvent = C.G_FILE_MONITOR_EVENT_RENAMED
+	FILE_MONITOR_EVENT_MOVED_IN  FileMonitorEvent = C.G_FILE_MONITOR_EVENT_MOVED_IN
+	FILE_MONITOR_EVENT_MOVED_OUT FileMonitorEvent = C.G_FILE_MONITOR_EVENT_MOVED_OUT
+)
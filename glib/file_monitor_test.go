@@ -0,0 +1,44 @@
+package glib_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestFileMonitorDirectory(t *testing.T) {
+	dir := t.TempDir()
+	file, err := glib.FileForPathNew(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	monitor, err := file.MonitorDirectory(glib.FILE_MONITOR_NONE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer monitor.Cancel()
+
+	created := false
+	monitor.ConnectChanged(func(file, otherFile *glib.File, event glib.FileMonitorEvent) {
+		if event == glib.FILE_MONITOR_EVENT_CREATED && file.GetBasename() == "new" {
+			created = true
+		}
+	})
+
+	if err := os.WriteFile(filepath.Join(dir, "new"), []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	ctx := glib.MainContextDefault()
+	deadline := time.Now().Add(5 * time.Second)
+	for !created && time.Now().Before(deadline) {
+		if !ctx.Iteration(false) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	if !created {
+		t.Error("Expected a created event for the new file")
+	}
+}
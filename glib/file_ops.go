@@ -0,0 +1,368 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "file_ops.go.h"
+import "C"
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// FileCopyFlags is a representation of GLib's GFileCopyFlags.
+type FileCopyFlags int
+
+const (
+	FILE_COPY_NONE                 FileCopyFlags = C.G_FILE_COPY_NONE
+	FILE_COPY_OVERWRITE            FileCopyFlags = C.G_FILE_COPY_OVERWRITE
+	FILE_COPY_BACKUP               FileCopyFlags = C.G_FILE_COPY_BACKUP
+	FILE_COPY_NOFOLLOW_SYMLINKS    FileCopyFlags = C.G_FILE_COPY_NOFOLLOW_SYMLINKS
+	FILE_COPY_ALL_METADATA         FileCopyFlags = C.G_FILE_COPY_ALL_METADATA
+	FILE_COPY_NO_FALLBACK_FOR_MOVE FileCopyFlags = C.G_FILE_COPY_NO_FALLBACK_FOR_MOVE
+	FILE_COPY_TARGET_DEFAULT_PERMS FileCopyFlags = C.G_FILE_COPY_TARGET_DEFAULT_PERMS
+)
+
+// FileProgressCallback is the Go equivalent of GFileProgressCallback,
+// which reports the progress of copy and move operations.
+type FileProgressCallback func(currentNumBytes, totalNumBytes int64)
+
+var (
+	fileProgressCallbackRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]FileProgressCallback
+	}{
+		next: 1,
+		m:    make(map[int]FileProgressCallback),
+	}
+)
+
+// registerFileProgressCallback stores progress until the operation
+// completes and returns the C callback along with its user data.
+// A nil progress disables progress reporting.
+func registerFileProgressCallback(progress FileProgressCallback) (C.GFileProgressCallback, C.gpointer) {
+	if progress == nil {
+		return nil, nil
+	}
+
+	fileProgressCallbackRegistry.Lock()
+	id := fileProgressCallbackRegistry.next
+	fileProgressCallbackRegistry.next++
+	fileProgressCallbackRegistry.m[id] = progress
+	fileProgressCallbackRegistry.Unlock()
+
+	return C.GFileProgressCallback(C.goFileProgressCallback), C.gpointer(uintptr(id))
+}
+
+func unregisterFileProgressCallback(data C.gpointer) {
+	if data == nil {
+		return
+	}
+
+	fileProgressCallbackRegistry.Lock()
+	delete(fileProgressCallbackRegistry.m, int(uintptr(data)))
+	fileProgressCallbackRegistry.Unlock()
+}
+
+//export goFileProgressCallback
+func goFileProgressCallback(currentNumBytes, totalNumBytes C.goffset, userData C.gpointer) {
+	id := int(uintptr(userData))
+
+	fileProgressCallbackRegistry.RLock()
+	fn := fileProgressCallbackRegistry.m[id]
+	fileProgressCallbackRegistry.RUnlock()
+
+	if fn != nil {
+		fn(int64(currentNumBytes), int64(totalNumBytes))
+	}
+}
+
+/*
+ * GFile copy and move
+ */
+
+// gboolean	g_file_copy ()
+// progress may be nil, otherwise it is called synchronously
+// from the calling thread while the copy is running.
+func (v *File) Copy(destination *File, flags FileCopyFlags, cancel *Cancellable,
+	progress FileProgressCallback) error {
+	fn, data := registerFileProgressCallback(progress)
+	defer unregisterFileProgressCallback(data)
+
+	var err *C.GError
+	c := C.g_file_copy(v.native(), destination.native(), C.GFileCopyFlags(flags),
+		cancel.native(), fn, data, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// void	g_file_copy_async ()
+// progress may be nil, otherwise it is called from the main context
+// the operation was started from, so it may update widgets directly.
+func (v *File) CopyAsync(destination *File, flags FileCopyFlags, ioPriority int,
+	cancel *Cancellable, progress FileProgressCallback, callback AsyncReadyCallback) {
+	fn, data := registerFileProgressCallback(progress)
+
+	C.g_file_copy_async(v.native(), destination.native(), C.GFileCopyFlags(flags),
+		C.int(ioPriority), cancel.native(), fn, data, asyncReadyCallback(),
+		registerAsyncReadyCallback(func(source *Object, result *AsyncResult) {
+			unregisterFileProgressCallback(data)
+			if callback != nil {
+				callback(source, result)
+			}
+		}))
+}
+
+// gboolean	g_file_copy_finish ()
+func (v *File) CopyFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_file_copy_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// gboolean	g_file_move ()
+// progress may be nil, otherwise it is called synchronously
+// from the calling thread while the move is running.
+func (v *File) Move(destination *File, flags FileCopyFlags, cancel *Cancellable,
+	progress FileProgressCallback) error {
+	fn, data := registerFileProgressCallback(progress)
+	defer unregisterFileProgressCallback(data)
+
+	var err *C.GError
+	c := C.g_file_move(v.native(), destination.native(), C.GFileCopyFlags(flags),
+		cancel.native(), fn, data, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// MoveAsync runs Move in a Task, as g_file_move_async() is only
+// available since GLib 2.72. The callback should call MoveFinish.
+// Unlike with CopyAsync, progress is posted to the default main context
+// from the thread running the move, and updates still pending when the
+// callback is called are dropped, so that progress never follows it.
+func (v *File) MoveAsync(destination *File, flags FileCopyFlags, ioPriority int,
+	cancel *Cancellable, progress FileProgressCallback, callback AsyncReadyCallback) {
+	var done int32
+	source := wrapObject(unsafe.Pointer(v.native()))
+	task, err := TaskNew(source, cancel, func(source *Object, result *AsyncResult) {
+		atomic.StoreInt32(&done, 1)
+		if callback != nil {
+			callback(source, result)
+		}
+	})
+	if err != nil {
+		// Without a task, the callback gets a nil result,
+		// for which MoveFinish returns the error.
+		if callback != nil {
+			IdleAdd(func() {
+				callback(source, nil)
+			})
+		}
+		return
+	}
+	C.g_task_set_priority(task.native(), C.gint(ioPriority))
+
+	var mainProgress FileProgressCallback
+	if progress != nil {
+		mainProgress = func(currentNumBytes, totalNumBytes int64) {
+			IdleAdd(func() {
+				if atomic.LoadInt32(&done) == 0 {
+					progress(currentNumBytes, totalNumBytes)
+				}
+			})
+		}
+	}
+
+	task.Run(func(task *Task, cancel *Cancellable) {
+		if err := v.Move(destination, flags, cancel, mainProgress); err != nil {
+			task.ReturnError(err)
+			return
+		}
+		task.ReturnBoolean(true)
+	})
+}
+
+// MoveFinish completes an operation started with MoveAsync.
+func (v *File) MoveFinish(result *AsyncResult) error {
+	if result == nil {
+		return errNilPtr
+	}
+	task, err := TaskFromAsyncResult(result)
+	if err != nil {
+		return err
+	}
+	_, err = task.PropagateBoolean()
+	return err
+}
+
+/*
+ * GFile contents
+ */
+
+// gboolean	g_file_load_contents ()
+// The returned etag may be empty if the backend does not support it.
+func (v *File) LoadContents(cancel *Cancellable) ([]byte, string, error) {
+	var contents, etag *C.char
+	var length C.gsize
+	var err *C.GError
+	c := C.g_file_load_contents(v.native(), cancel.native(), &contents, &length, &etag, &err)
+	if c == 0 {
+		return nil, "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(contents))
+	defer C.g_free(C.gpointer(etag))
+
+	return C.GoBytes(unsafe.Pointer(contents), C.int(length)), goEtag(etag), nil
+}
+
+// void	g_file_load_contents_async ()
+func (v *File) LoadContentsAsync(cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_load_contents_async(v.native(), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// gboolean	g_file_load_contents_finish ()
+func (v *File) LoadContentsFinish(result *AsyncResult) ([]byte, string, error) {
+	var contents, etag *C.char
+	var length C.gsize
+	var err *C.GError
+	c := C.g_file_load_contents_finish(v.native(), result.native(), &contents, &length,
+		&etag, &err)
+	if c == 0 {
+		return nil, "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(contents))
+	defer C.g_free(C.gpointer(etag))
+
+	return C.GoBytes(unsafe.Pointer(contents), C.int(length)), goEtag(etag), nil
+}
+
+// gboolean	g_file_replace_contents ()
+// etag may be empty to skip the check against the current contents.
+// The etag of the new contents is returned.
+func (v *File) ReplaceContents(contents []byte, etag string, makeBackup bool,
+	flags FileCreateFlags, cancel *Cancellable) (string, error) {
+	cetag := cEtag(etag)
+	defer C.free(unsafe.Pointer(cetag))
+
+	// contents must not be NULL, even when empty.
+	var cdata *C.char
+	if len(contents) > 0 {
+		cdata = (*C.char)(unsafe.Pointer(&contents[0]))
+	} else {
+		cdata = C.CString("")
+		defer C.free(unsafe.Pointer(cdata))
+	}
+
+	var newEtag *C.char
+	var err *C.GError
+	c := C.g_file_replace_contents(v.native(), cdata, C.gsize(len(contents)), cetag,
+		gbool(makeBackup), C.GFileCreateFlags(flags), &newEtag, cancel.native(), &err)
+	if c == 0 {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(newEtag))
+
+	return goEtag(newEtag), nil
+}
+
+// void	g_file_replace_contents_bytes_async ()
+// contents are copied, so the slice may be reused right away.
+func (v *File) ReplaceContentsAsync(contents []byte, etag string, makeBackup bool,
+	flags FileCreateFlags, cancel *Cancellable, callback AsyncReadyCallback) {
+	cetag := cEtag(etag)
+	defer C.free(unsafe.Pointer(cetag))
+
+	var cdata C.gconstpointer
+	if len(contents) > 0 {
+		cdata = C.gconstpointer(unsafe.Pointer(&contents[0]))
+	}
+	bytes := C.g_bytes_new(cdata, C.gsize(len(contents)))
+	// the operation keeps its own reference.
+	defer C.g_bytes_unref(bytes)
+
+	C.g_file_replace_contents_bytes_async(v.native(), bytes, cetag, gbool(makeBackup),
+		C.GFileCreateFlags(flags), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// gboolean	g_file_replace_contents_finish ()
+func (v *File) ReplaceContentsFinish(result *AsyncResult) (string, error) {
+	var newEtag *C.char
+	var err *C.GError
+	c := C.g_file_replace_contents_finish(v.native(), result.native(), &newEtag, &err)
+	if c == 0 {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(newEtag))
+
+	return goEtag(newEtag), nil
+}
+
+// GFileOutputStream *	g_file_replace ()
+// etag may be empty to skip the check against the current contents.
+func (v *File) Replace(etag string, makeBackup bool, flags FileCreateFlags,
+	cancel *Cancellable) (*FileOutputStream, error) {
+	cetag := cEtag(etag)
+	defer C.free(unsafe.Pointer(cetag))
+
+	var err *C.GError
+	c := C.g_file_replace(v.native(), cetag, gbool(makeBackup), C.GFileCreateFlags(flags),
+		cancel.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileOutputStream(obj), nil
+}
+
+// void	g_file_replace_async ()
+func (v *File) ReplaceAsync(etag string, makeBackup bool, flags FileCreateFlags,
+	ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	cetag := cEtag(etag)
+	defer C.free(unsafe.Pointer(cetag))
+
+	C.g_file_replace_async(v.native(), cetag, gbool(makeBackup), C.GFileCreateFlags(flags),
+		C.int(ioPriority), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// GFileOutputStream *	g_file_replace_finish ()
+func (v *File) ReplaceFinish(result *AsyncResult) (*FileOutputStream, error) {
+	var err *C.GError
+	c := C.g_file_replace_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileOutputStream(obj), nil
+}
+
+// cEtag returns etag as a C string, or NULL if etag is empty.
+func cEtag(etag string) *C.char {
+	if etag == "" {
+		return nil
+	}
+	return C.CString(etag)
+}
+
+// goEtag returns the Go string of an etag which may be NULL.
+func goEtag(etag *C.char) string {
+	if etag == nil {
+		return ""
+	}
+	return C.GoString(etag)
+}
@@ -0,0 +1,14 @@
+// Same copyright and license as the rest of the files in this project
+
+// GFile copy, move and contents operations
+// See: https://developer.gnome.org/gio/stable/GFile.html
+
+#ifndef __GFILE_OPS_GO_H__
+#define __GFILE_OPS_GO_H__
+
+#include <gio/gio.h>
+
+extern void goFileProgressCallback(goffset current_num_bytes,
+                                   goffset total_num_bytes, gpointer user_data);
+
+#endif
@@ -0,0 +1,75 @@
+package glib_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestFileCopyMoveAndContents(t *testing.T) {
+	dir := t.TempDir()
+	newFile := func(name string) *glib.File {
+		file, err := glib.FileForPathNew(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+	src, copied, moved := newFile("src"), newFile("copied"), newFile("moved")
+
+	etag, err := src.ReplaceContents([]byte("contents"), "", false, glib.FILE_CREATE_NONE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The etag guards against concurrent changes.
+	if _, err := src.ReplaceContents([]byte("stale"), "0:0", false, glib.FILE_CREATE_NONE, nil); err == nil {
+		t.Error("Expected an error replacing contents with a stale etag")
+	}
+
+	var current, total int64
+	err = src.Copy(copied, glib.FILE_COPY_NONE, nil, func(currentNumBytes, totalNumBytes int64) {
+		current, total = currentNumBytes, totalNumBytes
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current != 8 || total != 8 {
+		t.Errorf("Expected the progress to reach 8 bytes, got %d of %d", current, total)
+	}
+
+	callback, ch := glib.AsyncResultChan()
+	var finished, lateProgress bool
+	copied.MoveAsync(moved, glib.FILE_COPY_NONE, glib.PRIORITY_DEFAULT, nil,
+		func(currentNumBytes, totalNumBytes int64) {
+			lateProgress = lateProgress || finished
+		},
+		func(source *glib.Object, result *glib.AsyncResult) {
+			finished = true
+			callback(source, result)
+		})
+	if err := copied.MoveFinish(waitResult(t, ch)); err != nil {
+		t.Fatal(err)
+	}
+	dispatchPending()
+	if lateProgress {
+		t.Error("Expected no progress after the move completed")
+	}
+	if _, err := os.Stat(copied.GetPath()); !os.IsNotExist(err) {
+		t.Errorf("Expected the moved file to be gone, got %v", err)
+	}
+
+	callback, ch = glib.AsyncResultChan()
+	moved.LoadContentsAsync(nil, callback)
+	data, movedEtag, err := moved.LoadContentsFinish(waitResult(t, ch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "contents" {
+		t.Errorf("Expected %q, got %q", "contents", data)
+	}
+	if etag == "" || movedEtag == "" {
+		t.Error("Expected local files to have an etag")
+	}
+}
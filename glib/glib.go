@@ -970,11 +970,9 @@ func InitI18n(domain string, dir string) {
 	C.init_i18n(domainStr, dirStr)
 }
 
-// Local localizes a string using gettext
+// Local localizes a string using gettext.
+//
+// Deprecated: Use Gettext, which Local is an alias of.
 func Local(input string) string {
-	cstr := C.CString(input)
-	defer C.free(unsafe.Pointer(cstr))
-
-	c := C.localize(cstr)
-	return goString((*C.gchar)(c))
+	return Gettext(input)
 }
@@ -56,6 +56,18 @@ toGMenuItem(void *p)
 	return (G_MENU_ITEM(p));
 }
 
+static GMenuAttributeIter *
+toGMenuAttributeIter(void *p)
+{
+	return (G_MENU_ATTRIBUTE_ITER(p));
+}
+
+static GMenuLinkIter *
+toGMenuLinkIter(void *p)
+{
+	return (G_MENU_LINK_ITER(p));
+}
+
 static GCancellable*
 toGCancellable(void *p)
 {
@@ -128,6 +140,12 @@ toGSeekable(void *p)
 	return (G_SEEKABLE(p));
 }
 
+static gboolean
+isGSeekable(void *p)
+{
+	return (G_IS_SEEKABLE(p));
+}
+
 
 static GFileIOStream*
 toGFileIOStream(void *p)
@@ -135,12 +153,174 @@ toGFileIOStream(void *p)
 	return (G_FILE_IO_STREAM(p));
 }
 
+static GFilterInputStream*
+toGFilterInputStream(void *p)
+{
+	return (G_FILTER_INPUT_STREAM(p));
+}
+
+static GFilterOutputStream*
+toGFilterOutputStream(void *p)
+{
+	return (G_FILTER_OUTPUT_STREAM(p));
+}
+
+static GConverter*
+toGConverter(void *p)
+{
+	return (G_CONVERTER(p));
+}
+
+static GConverterInputStream*
+toGConverterInputStream(void *p)
+{
+	return (G_CONVERTER_INPUT_STREAM(p));
+}
+
+static GConverterOutputStream*
+toGConverterOutputStream(void *p)
+{
+	return (G_CONVERTER_OUTPUT_STREAM(p));
+}
+
+static GZlibCompressor*
+toGZlibCompressor(void *p)
+{
+	return (G_ZLIB_COMPRESSOR(p));
+}
+
+static GZlibDecompressor*
+toGZlibDecompressor(void *p)
+{
+	return (G_ZLIB_DECOMPRESSOR(p));
+}
+
+static GCharsetConverter*
+toGCharsetConverter(void *p)
+{
+	return (G_CHARSET_CONVERTER(p));
+}
+
+static GBufferedInputStream*
+toGBufferedInputStream(void *p)
+{
+	return (G_BUFFERED_INPUT_STREAM(p));
+}
+
+static GBufferedOutputStream*
+toGBufferedOutputStream(void *p)
+{
+	return (G_BUFFERED_OUTPUT_STREAM(p));
+}
+
+static GDataInputStream*
+toGDataInputStream(void *p)
+{
+	return (G_DATA_INPUT_STREAM(p));
+}
+
+static GDataOutputStream*
+toGDataOutputStream(void *p)
+{
+	return (G_DATA_OUTPUT_STREAM(p));
+}
+
 static GFile *
 toGFile(void *p)
 {
 	return (G_FILE(p));
 }
 
+static GFileMonitor *
+toGFileMonitor(void *p)
+{
+	return (G_FILE_MONITOR(p));
+}
+
+static GSubprocess *
+toGSubprocess(void *p)
+{
+	return (G_SUBPROCESS(p));
+}
+
+static GSubprocessLauncher *
+toGSubprocessLauncher(void *p)
+{
+	return (G_SUBPROCESS_LAUNCHER(p));
+}
+
+static GAppInfo *
+toGAppInfo(void *p)
+{
+	return (G_APP_INFO(p));
+}
+
+static GAppLaunchContext *
+toGAppLaunchContext(void *p)
+{
+	return (G_APP_LAUNCH_CONTEXT(p));
+}
+
+static GInetAddress *
+toGInetAddress(void *p)
+{
+	return (G_INET_ADDRESS(p));
+}
+
+static GSocketAddress *
+toGSocketAddress(void *p)
+{
+	return (G_SOCKET_ADDRESS(p));
+}
+
+static GInetSocketAddress *
+toGInetSocketAddress(void *p)
+{
+	return (G_INET_SOCKET_ADDRESS(p));
+}
+
+static GSocketConnectable *
+toGSocketConnectable(void *p)
+{
+	return (G_SOCKET_CONNECTABLE(p));
+}
+
+static GSocketConnection *
+toGSocketConnection(void *p)
+{
+	return (G_SOCKET_CONNECTION(p));
+}
+
+static GSocketClient *
+toGSocketClient(void *p)
+{
+	return (G_SOCKET_CLIENT(p));
+}
+
+static GSocketListener *
+toGSocketListener(void *p)
+{
+	return (G_SOCKET_LISTENER(p));
+}
+
+static GSocketService *
+toGSocketService(void *p)
+{
+	return (G_SOCKET_SERVICE(p));
+}
+
+static GThreadedSocketService *
+toGThreadedSocketService(void *p)
+{
+	return (G_THREADED_SOCKET_SERVICE(p));
+}
+
+static gboolean
+isGInetSocketAddress(void *p)
+{
+	return (G_IS_INET_SOCKET_ADDRESS(p));
+}
+
 static GIcon *
 toGIcon(void *p)
 {
@@ -307,10 +487,6 @@ static void init_i18n(const char *domain, const char *dir) {
   textdomain(domain);
 }
 
-static const char* localize(const char *string) {
-  return _(string);
-}
-
 static inline char** make_strings(int count) {
 	return (char**)malloc(sizeof(char*) * count);
 }
@@ -0,0 +1,5 @@
+// Same copyright and license as the rest of the files in this project
+
+// Package glibtest provides helpers for the tests of programs using
+// GLib. It is only meant to be imported by tests.
+package glibtest
@@ -0,0 +1,16 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glibtest
+
+import (
+	"github.com/romychs/gotk3/glib"
+)
+
+// LogPanicOnCritical makes the critical and error messages panic with
+// glib.SetLogPanicLevels. It is typically called by the TestMain function
+// or the init function of a test package, so that critical messages,
+// such as those of GTK functions called with invalid arguments, fail the
+// tests.
+func LogPanicOnCritical() {
+	glib.SetLogPanicLevels(glib.LOG_LEVEL_CRITICAL | glib.LOG_LEVEL_ERROR)
+}
@@ -32,3 +32,15 @@ func MainContextDefault() *MainContext {
 func MainDepth() int {
 	return int(C.g_main_depth())
 }
+
+// Iteration is a wrapper around g_main_context_iteration(). It dispatches
+// the sources of the context which are ready, waiting for one if mayBlock
+// is true, and returns whether any was dispatched.
+func (v *MainContext) Iteration(mayBlock bool) bool {
+	return gobool(C.g_main_context_iteration(v.native(), gbool(mayBlock)))
+}
+
+// Pending is a wrapper around g_main_context_pending().
+func (v *MainContext) Pending() bool {
+	return gobool(C.g_main_context_pending(v.native()))
+}
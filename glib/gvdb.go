@@ -0,0 +1,186 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+import (
+	"encoding/binary"
+	"sort"
+	"strings"
+)
+
+// This file implements the subset of the GVDB file format needed to
+// serialize a GResource bundle, as glib-compile-resources does, so that
+// resources may be built at runtime from files embedded in the binary.
+// See gvdb-format.h in the GLib sources for the layout.
+
+const (
+	gvdbSignature0 = 0x72615647 // "GVar"
+	gvdbSignature1 = 0x746e6169 // "iant"
+
+	gvdbHeaderSize   = 24
+	gvdbHashItemSize = 24
+	gvdbNoParent     = 0xffffffff
+)
+
+type gvdbItem struct {
+	key      string // full path
+	parent   *gvdbItem
+	index    int
+	data     []byte // file contents, for non-directory items
+	children []*gvdbItem
+}
+
+// gvdbHash is the hash function of GVDB, which is the
+// djb hash computed over signed chars.
+func gvdbHash(key string) uint32 {
+	hash := uint32(5381)
+	for i := 0; i < len(key); i++ {
+		hash = hash*33 + uint32(int32(int8(key[i])))
+	}
+	return hash
+}
+
+// gvdbResourceValue serializes the "(uuay)" tuple GResource stores for
+// every file, wrapped in a variant: size, flags and contents. Like
+// glib-compile-resources does, the contents are nul-terminated, which
+// GResource expects and strips.
+func gvdbResourceValue(data []byte) []byte {
+	const typ = "(uuay)"
+	buf := make([]byte, 8, 8+len(data)+2+len(typ))
+	binary.LittleEndian.PutUint32(buf[0:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(RESOURCE_FLAGS_NONE))
+	buf = append(buf, data...)
+	// nul terminator of the contents, then separator of the variant type.
+	buf = append(buf, 0, 0)
+	return append(buf, typ...)
+}
+
+// gvdbResourceBundle returns the GResource bundle holding files, which
+// maps absolute resource paths, such as "/org/example/app/main.ui",
+// to their contents.
+func gvdbResourceBundle(files map[string][]byte) []byte {
+	items := make(map[string]*gvdbItem)
+
+	var dir func(path string) *gvdbItem
+	dir = func(path string) *gvdbItem {
+		if item, ok := items[path]; ok {
+			return item
+		}
+		item := &gvdbItem{key: path}
+		items[path] = item
+		if path != "/" {
+			i := strings.LastIndex(path[:len(path)-1], "/")
+			item.parent = dir(path[:i+1])
+			item.parent.children = append(item.parent.children, item)
+		}
+		return item
+	}
+
+	for path, data := range files {
+		i := strings.LastIndex(path, "/")
+		parent := dir(path[:i+1])
+		item := &gvdbItem{key: path, parent: parent, data: data}
+		items[path] = item
+		parent.children = append(parent.children, item)
+	}
+
+	// Items of the same bucket must be contiguous; sorting by key
+	// first keeps the output deterministic.
+	nBuckets := uint32(len(items))
+	if nBuckets == 0 {
+		nBuckets = 1
+	}
+	sorted := make([]*gvdbItem, 0, len(items))
+	for _, item := range items {
+		sorted = append(sorted, item)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		bi := gvdbHash(sorted[i].key) % nBuckets
+		bj := gvdbHash(sorted[j].key) % nBuckets
+		if bi != bj {
+			return bi < bj
+		}
+		return sorted[i].key < sorted[j].key
+	})
+	for i, item := range sorted {
+		item.index = i
+	}
+
+	tableStart := uint32(gvdbHeaderSize)
+	itemsStart := tableStart + 8 + 4*nBuckets
+	offset := itemsStart + gvdbHashItemSize*uint32(len(sorted))
+
+	out := make([]byte, offset)
+	le := binary.LittleEndian
+
+	le.PutUint32(out[0:], gvdbSignature0)
+	le.PutUint32(out[4:], gvdbSignature1)
+	le.PutUint32(out[8:], 0)  // version
+	le.PutUint32(out[12:], 0) // options
+	le.PutUint32(out[16:], tableStart)
+	le.PutUint32(out[20:], offset)
+
+	// No bloom filter.
+	le.PutUint32(out[tableStart:], 0)
+	le.PutUint32(out[tableStart+4:], nBuckets)
+	// Each bucket points to its first item, or to the
+	// first item of the next bucket in use if it is empty.
+	for b := uint32(0); b < nBuckets; b++ {
+		p := tableStart + 8 + 4*b
+		start := uint32(len(sorted))
+		for i, item := range sorted {
+			if gvdbHash(item.key)%nBuckets >= b {
+				start = uint32(i)
+				break
+			}
+		}
+		le.PutUint32(out[p:], start)
+	}
+
+	align := func(n int) {
+		for len(out)%n != 0 {
+			out = append(out, 0)
+		}
+	}
+
+	for i, item := range sorted {
+		p := itemsStart + gvdbHashItemSize*uint32(i)
+
+		key := item.key
+		parent := uint32(gvdbNoParent)
+		if item.parent != nil {
+			key = item.key[len(item.parent.key):]
+			parent = uint32(item.parent.index)
+		}
+
+		le.PutUint32(out[p:], gvdbHash(item.key))
+		le.PutUint32(out[p+4:], parent)
+		le.PutUint32(out[p+8:], uint32(len(out)))
+		le.PutUint16(out[p+12:], uint16(len(key)))
+		out = append(out, key...)
+
+		var start int
+		if strings.HasSuffix(item.key, "/") {
+			out[p+14] = 'L'
+			align(4)
+			start = len(out)
+			sort.Slice(item.children, func(i, j int) bool {
+				return item.children[i].index < item.children[j].index
+			})
+			for _, child := range item.children {
+				var buf [4]byte
+				le.PutUint32(buf[:], uint32(child.index))
+				out = append(out, buf[:]...)
+			}
+		} else {
+			out[p+14] = 'v'
+			align(8)
+			start = len(out)
+			out = append(out, gvdbResourceValue(item.data)...)
+		}
+		le.PutUint32(out[p+16:], uint32(start))
+		le.PutUint32(out[p+20:], uint32(len(out)))
+	}
+
+	return out
+}
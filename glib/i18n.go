@@ -0,0 +1,150 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+// The functions of this file use the gettext functions of the C library
+// rather than their GLib counterparts, such as g_dgettext(), which decide
+// once and for all whether to translate, so that the locale can be
+// switched at runtime.
+
+// LocaleCategory is a representation of the categories of setlocale().
+type LocaleCategory int
+
+const (
+	LC_ALL      LocaleCategory = C.LC_ALL
+	LC_COLLATE  LocaleCategory = C.LC_COLLATE
+	LC_CTYPE    LocaleCategory = C.LC_CTYPE
+	LC_MESSAGES LocaleCategory = C.LC_MESSAGES
+	LC_MONETARY LocaleCategory = C.LC_MONETARY
+	LC_NUMERIC  LocaleCategory = C.LC_NUMERIC
+	LC_TIME     LocaleCategory = C.LC_TIME
+)
+
+// SetLocale is a wrapper around setlocale(). It sets the locale of
+// category, such as "de_DE.UTF-8", or the one of the environment if
+// locale is empty, and returns the name of the new locale. This lets
+// tests switch the language of the translations at runtime. An error is
+// returned if the locale is not installed.
+//
+// setlocale() affects every thread of the program, so SetLocale must not
+// be called while other goroutines translate strings or format values.
+// Note that the LANGUAGE environment variable, if set, takes precedence
+// over the locale for the translations.
+func SetLocale(category LocaleCategory, locale string) (string, error) {
+	cstr := C.CString(locale)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.setlocale(C.int(category), cstr)
+	if c == nil {
+		return "", errors.New("unsupported locale: " + locale)
+	}
+	return C.GoString(c), nil
+}
+
+// GetLocale returns the name of the current locale of category.
+func GetLocale(category LocaleCategory) string {
+	return C.GoString(C.setlocale(C.int(category), nil))
+}
+
+// BindTextDomain is a wrapper around bindtextdomain(). The translations of
+// domain are looked up in dir, like "/usr/share/locale", and are returned
+// encoded in UTF-8. It lets libraries use their own domain, along with
+// the default one of the application set by InitI18n.
+func BindTextDomain(domain, dir string) {
+	cdomain := C.CString(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+	cdir := C.CString(dir)
+	defer C.free(unsafe.Pointer(cdir))
+	ccodeset := C.CString("UTF-8")
+	defer C.free(unsafe.Pointer(ccodeset))
+
+	C.bindtextdomain(cdomain, cdir)
+	C.bind_textdomain_codeset(cdomain, ccodeset)
+}
+
+// TextDomain is a wrapper around textdomain(). It sets the default domain
+// of the translations, and returns the previous one.
+func TextDomain(domain string) string {
+	cdomain := C.CString(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+
+	return C.GoString(C.textdomain(cdomain))
+}
+
+// Gettext is a wrapper around gettext(). It is the equivalent of the _()
+// macro, returning the translation of msgid in the default domain, or
+// msgid itself if there is none.
+func Gettext(msgid string) string {
+	return Dgettext("", msgid)
+}
+
+// Ngettext is a wrapper around ngettext(). It returns the translation of
+// msgid, in its singular form, or msgidPlural, in its plural form, in
+// the plural form matching n in the current language.
+func Ngettext(msgid, msgidPlural string, n uint64) string {
+	return Dngettext("", msgid, msgidPlural, n)
+}
+
+// Pgettext is the equivalent of the C_() macro. It returns the translation
+// of msgid in the given context, which tells apart identical messages
+// with different meanings, like "Open" in a "menu" and in a "status".
+func Pgettext(context, msgid string) string {
+	return Dpgettext("", context, msgid)
+}
+
+// cTextDomain returns domain as a C string, or nil if it is empty, to be
+// freed with C.free().
+func cTextDomain(domain string) *C.char {
+	if domain == "" {
+		return nil
+	}
+	return C.CString(domain)
+}
+
+// Dgettext is a wrapper around dgettext(). It is the equivalent of
+// Gettext for libraries having their own domain. domain is the default
+// domain if empty.
+func Dgettext(domain, msgid string) string {
+	cdomain := cTextDomain(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+	cmsgid := C.CString(msgid)
+	defer C.free(unsafe.Pointer(cmsgid))
+
+	return C.GoString(C.dgettext(cdomain, cmsgid))
+}
+
+// Dngettext is a wrapper around dngettext(). It is the equivalent of
+// Ngettext for libraries having their own domain. domain is the default
+// domain if empty.
+func Dngettext(domain, msgid, msgidPlural string, n uint64) string {
+	cdomain := cTextDomain(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+	cmsgid := C.CString(msgid)
+	defer C.free(unsafe.Pointer(cmsgid))
+	cplural := C.CString(msgidPlural)
+	defer C.free(unsafe.Pointer(cplural))
+
+	return C.GoString(C.dngettext(cdomain, cmsgid, cplural, C.ulong(n)))
+}
+
+// Dpgettext is the equivalent of Pgettext for libraries having their own
+// domain, like g_dpgettext2() is. domain is the default domain if empty.
+func Dpgettext(domain, context, msgid string) string {
+	// Messages with a context are stored with the context as a prefix,
+	// separated by an EOT character.
+	key := context + "\x04" + msgid
+	if translation := Dgettext(domain, key); translation != key {
+		return translation
+	}
+	return msgid
+}
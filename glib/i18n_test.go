@@ -0,0 +1,94 @@
+package glib_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+// writeMO writes a gettext .mo catalog holding translations.
+func writeMO(t *testing.T, path string, translations map[string]string) {
+	keys := make([]string, 0, len(translations))
+	for key := range translations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	n := uint32(len(keys))
+	const headerSize = 7 * 4
+	var strs bytes.Buffer
+	offset := headerSize + 2*8*n
+	table := make([]uint32, 0, 4*n)
+	add := func(s string) {
+		table = append(table, uint32(len(s)), offset+uint32(strs.Len()))
+		strs.WriteString(s)
+		strs.WriteByte(0)
+	}
+	for _, key := range keys {
+		add(key)
+	}
+	for _, key := range keys {
+		add(translations[key])
+	}
+
+	var buf bytes.Buffer
+	header := []uint32{0x950412de, 0, n, headerSize, headerSize + 8*n, 0, 0}
+	binary.Write(&buf, binary.LittleEndian, header)
+	binary.Write(&buf, binary.LittleEndian, table)
+	buf.Write(strs.Bytes())
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNgettext_Untranslated(t *testing.T) {
+	if s := glib.Ngettext("%d file", "%d files", 1); s != "%d file" {
+		t.Errorf("Expected %q, got %q", "%d file", s)
+	}
+	if s := glib.Ngettext("%d file", "%d files", 2); s != "%d files" {
+		t.Errorf("Expected %q, got %q", "%d files", s)
+	}
+	if s := glib.Pgettext("menu", "Open"); s != "Open" {
+		t.Errorf("Expected %q, got %q", "Open", s)
+	}
+}
+
+func TestDgettext(t *testing.T) {
+	previous := glib.GetLocale(glib.LC_ALL)
+	if _, err := glib.SetLocale(glib.LC_ALL, "de_DE.UTF-8"); err != nil {
+		t.Skip(err)
+	}
+	defer glib.SetLocale(glib.LC_ALL, previous)
+	t.Setenv("LANGUAGE", "")
+
+	dir := t.TempDir()
+	writeMO(t, filepath.Join(dir, "de", "LC_MESSAGES", "gotk3-test.mo"), map[string]string{
+		"":                    "Content-Type: text/plain; charset=UTF-8\nPlural-Forms: nplurals=2; plural=(n != 1);\n",
+		"Quit":                "Beenden",
+		"%d file\x00%d files": "%d Datei\x00%d Dateien",
+		"menu\x04Open":        "Öffnen",
+	})
+	glib.BindTextDomain("gotk3-test", dir)
+
+	if s := glib.Dgettext("gotk3-test", "Quit"); s != "Beenden" {
+		t.Errorf("Expected %q, got %q", "Beenden", s)
+	}
+	if s := glib.Dngettext("gotk3-test", "%d file", "%d files", 3); s != "%d Dateien" {
+		t.Errorf("Expected %q, got %q", "%d Dateien", s)
+	}
+	if s := glib.Dpgettext("gotk3-test", "menu", "Open"); s != "Öffnen" {
+		t.Errorf("Expected %q, got %q", "Öffnen", s)
+	}
+	if s := glib.Dpgettext("gotk3-test", "status", "Open"); s != "Open" {
+		t.Errorf("Expected %q, got %q", "Open", s)
+	}
+}
@@ -7,6 +7,7 @@ package glib
 // #include "glib.go.h"
 import "C"
 import (
+	"runtime"
 	"unsafe"
 )
 
@@ -31,6 +32,28 @@ func wrapIcon(intf Interface) *Icon {
 	return &Icon{intf}
 }
 
+// takeIcon wraps an icon returned with a full reference. Unlike ThemedIcon
+// and FileIcon, Icon holds no Object to keep the reference, so it is
+// released when the returned Icon is collected.
+func takeIcon(c *C.GIcon) *Icon {
+	icon := wrapIcon(*InterfaceNew(unsafe.Pointer(c)))
+	runtime.SetFinalizer(icon, func(v *Icon) {
+		C.g_object_unref(C.gpointer(v.native()))
+	})
+	return icon
+}
+
+// ToString is a wrapper around g_icon_to_string(). It returns an empty
+// string if the icon cannot be serialized.
+func (v *Icon) ToString() string {
+	c := C.g_icon_to_string(v.native())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
 /*
 func IconForStringNew(path string) (*Icon, error) {
 	cstr := C.CString(path)
@@ -39,8 +62,7 @@ func IconForStringNew(path string) (*Icon, error) {
 	var err *C.GError = nil
 	c := C.g_icon_new_for_string((*C.gchar)(cstr), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 
 	obj := Take(unsafe.Pointer(c))
@@ -0,0 +1,858 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+// KeyFileFlags is a representation of GLib's GKeyFileFlags.
+type KeyFileFlags int
+
+const (
+	KEY_FILE_NONE              KeyFileFlags = C.G_KEY_FILE_NONE
+	KEY_FILE_KEEP_COMMENTS     KeyFileFlags = C.G_KEY_FILE_KEEP_COMMENTS
+	KEY_FILE_KEEP_TRANSLATIONS KeyFileFlags = C.G_KEY_FILE_KEEP_TRANSLATIONS
+)
+
+// KeyFileErrorCode is a representation of GLib's GKeyFileError.
+type KeyFileErrorCode int
+
+const (
+	KEY_FILE_ERROR_UNKNOWN_ENCODING KeyFileErrorCode = C.G_KEY_FILE_ERROR_UNKNOWN_ENCODING
+	KEY_FILE_ERROR_PARSE            KeyFileErrorCode = C.G_KEY_FILE_ERROR_PARSE
+	KEY_FILE_ERROR_NOT_FOUND        KeyFileErrorCode = C.G_KEY_FILE_ERROR_NOT_FOUND
+	KEY_FILE_ERROR_KEY_NOT_FOUND    KeyFileErrorCode = C.G_KEY_FILE_ERROR_KEY_NOT_FOUND
+	KEY_FILE_ERROR_GROUP_NOT_FOUND  KeyFileErrorCode = C.G_KEY_FILE_ERROR_GROUP_NOT_FOUND
+	KEY_FILE_ERROR_INVALID_VALUE    KeyFileErrorCode = C.G_KEY_FILE_ERROR_INVALID_VALUE
+)
+
+// Group and keys of desktop entry files, as defined by the freedesktop
+// Desktop Entry Specification.
+const (
+	KEY_FILE_DESKTOP_GROUP = "Desktop Entry"
+
+	KEY_FILE_DESKTOP_KEY_TYPE             = "Type"
+	KEY_FILE_DESKTOP_KEY_VERSION          = "Version"
+	KEY_FILE_DESKTOP_KEY_NAME             = "Name"
+	KEY_FILE_DESKTOP_KEY_GENERIC_NAME     = "GenericName"
+	KEY_FILE_DESKTOP_KEY_NO_DISPLAY       = "NoDisplay"
+	KEY_FILE_DESKTOP_KEY_COMMENT          = "Comment"
+	KEY_FILE_DESKTOP_KEY_ICON             = "Icon"
+	KEY_FILE_DESKTOP_KEY_HIDDEN           = "Hidden"
+	KEY_FILE_DESKTOP_KEY_ONLY_SHOW_IN     = "OnlyShowIn"
+	KEY_FILE_DESKTOP_KEY_NOT_SHOW_IN      = "NotShowIn"
+	KEY_FILE_DESKTOP_KEY_TRY_EXEC         = "TryExec"
+	KEY_FILE_DESKTOP_KEY_EXEC             = "Exec"
+	KEY_FILE_DESKTOP_KEY_PATH             = "Path"
+	KEY_FILE_DESKTOP_KEY_TERMINAL         = "Terminal"
+	KEY_FILE_DESKTOP_KEY_MIME_TYPE        = "MimeType"
+	KEY_FILE_DESKTOP_KEY_CATEGORIES       = "Categories"
+	KEY_FILE_DESKTOP_KEY_STARTUP_NOTIFY   = "StartupNotify"
+	KEY_FILE_DESKTOP_KEY_STARTUP_WM_CLASS = "StartupWMClass"
+	KEY_FILE_DESKTOP_KEY_URL              = "URL"
+	KEY_FILE_DESKTOP_KEY_ACTIONS          = "Actions"
+	KEY_FILE_DESKTOP_KEY_DBUS_ACTIVATABLE = "DBusActivatable"
+
+	KEY_FILE_DESKTOP_TYPE_APPLICATION = "Application"
+	KEY_FILE_DESKTOP_TYPE_LINK        = "Link"
+	KEY_FILE_DESKTOP_TYPE_DIRECTORY   = "Directory"
+)
+
+// KeyFileErrorQuark is a wrapper around g_key_file_error_quark().
+func KeyFileErrorQuark() Quark {
+	return Quark(C.g_key_file_error_quark())
+}
+
+// Errors of the KeyFileErrorQuark domain, returned by the methods of
+// KeyFile when a file is malformed, or a group or key is not found.
+var (
+	ErrKeyFileUnknownEncoding = NewError(KeyFileErrorQuark(), int(KEY_FILE_ERROR_UNKNOWN_ENCODING), "unknown encoding")
+	ErrKeyFileParse           = NewError(KeyFileErrorQuark(), int(KEY_FILE_ERROR_PARSE), "invalid key file")
+	ErrKeyFileNotFound        = NewError(KeyFileErrorQuark(), int(KEY_FILE_ERROR_NOT_FOUND), "key file not found")
+	ErrKeyFileKeyNotFound     = NewError(KeyFileErrorQuark(), int(KEY_FILE_ERROR_KEY_NOT_FOUND), "key not found")
+	ErrKeyFileGroupNotFound   = NewError(KeyFileErrorQuark(), int(KEY_FILE_ERROR_GROUP_NOT_FOUND), "group not found")
+	ErrKeyFileInvalidValue    = NewError(KeyFileErrorQuark(), int(KEY_FILE_ERROR_INVALID_VALUE), "invalid value")
+)
+
+/*
+ * GKeyFile
+ */
+
+// KeyFile is a representation of GLib's GKeyFile, which reads and
+// writes .ini like files, such as desktop entries, following the
+// freedesktop rules for lists, localized keys and comments.
+type KeyFile struct {
+	keyFile *C.GKeyFile
+}
+
+// Native returns a pointer to the underlying GKeyFile.
+func (v *KeyFile) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// native returns a pointer to the underlying GKeyFile.
+func (v *KeyFile) native() *C.GKeyFile {
+	if v == nil {
+		return nil
+	}
+	return v.keyFile
+}
+
+func marshalKeyFile(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	keyFile := (*C.GKeyFile)(unsafe.Pointer(c))
+	C.g_key_file_ref(keyFile)
+	return wrapKeyFile(keyFile), nil
+}
+
+// wrapKeyFile wraps a full reference to a GKeyFile, which is
+// released once the KeyFile is garbage collected.
+func wrapKeyFile(keyFile *C.GKeyFile) *KeyFile {
+	v := &KeyFile{keyFile}
+	runtime.SetFinalizer(v, func(v *KeyFile) {
+		C.g_key_file_unref(v.keyFile)
+	})
+	return v
+}
+
+// KeyFileNew is a wrapper around g_key_file_new().
+func KeyFileNew() *KeyFile {
+	return wrapKeyFile(C.g_key_file_new())
+}
+
+// SetListSeparator is a wrapper around g_key_file_set_list_separator().
+// The default separator is ';'.
+func (v *KeyFile) SetListSeparator(separator byte) {
+	C.g_key_file_set_list_separator(v.native(), C.gchar(separator))
+}
+
+// LoadFromFile is a wrapper around g_key_file_load_from_file().
+func (v *KeyFile) LoadFromFile(file string, flags KeyFileFlags) error {
+	cstr := C.CString(file)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_load_from_file(v.native(), cstr, C.GKeyFileFlags(flags), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// LoadFromData is a wrapper around g_key_file_load_from_data().
+func (v *KeyFile) LoadFromData(data string, flags KeyFileFlags) error {
+	cstr := C.CString(data)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_load_from_data(v.native(), cstr, C.gsize(len(data)),
+		C.GKeyFileFlags(flags), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// LoadFromDirs is a wrapper around g_key_file_load_from_dirs().
+// file is a relative path, searched in each of searchDirs in order.
+// The full path of the loaded file is returned.
+func (v *KeyFile) LoadFromDirs(file string, searchDirs []string, flags KeyFileFlags) (string, error) {
+	cstr := C.CString(file)
+	defer C.free(unsafe.Pointer(cstr))
+
+	cdirs := C.make_strings(C.int(len(searchDirs) + 1))
+	defer C.destroy_strings(cdirs)
+
+	for i, dir := range searchDirs {
+		cdir := C.CString(dir)
+		defer C.free(unsafe.Pointer(cdir))
+		C.set_string(cdirs, C.int(i), cdir)
+	}
+	C.set_string(cdirs, C.int(len(searchDirs)), nil)
+
+	var fullPath *C.gchar
+	var err *C.GError
+	c := C.g_key_file_load_from_dirs(v.native(), cstr, (**C.gchar)(unsafe.Pointer(cdirs)),
+		&fullPath, C.GKeyFileFlags(flags), &err)
+	if c == 0 {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(fullPath))
+
+	return goString(fullPath), nil
+}
+
+// LoadFromDataDirs is a wrapper around g_key_file_load_from_data_dirs().
+// file is a relative path, searched in the XDG user and system data
+// directories. The full path of the loaded file is returned.
+func (v *KeyFile) LoadFromDataDirs(file string, flags KeyFileFlags) (string, error) {
+	cstr := C.CString(file)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var fullPath *C.gchar
+	var err *C.GError
+	c := C.g_key_file_load_from_data_dirs(v.native(), cstr, &fullPath,
+		C.GKeyFileFlags(flags), &err)
+	if c == 0 {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(fullPath))
+
+	return goString(fullPath), nil
+}
+
+// ToData is a wrapper around g_key_file_to_data().
+func (v *KeyFile) ToData() (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_to_data(v.native(), &length, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return C.GoStringN((*C.char)(c), C.int(length)), nil
+}
+
+// SaveToFile is a wrapper around g_key_file_save_to_file().
+// The file is replaced atomically.
+func (v *KeyFile) SaveToFile(filename string) error {
+	cstr := C.CString(filename)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_save_to_file(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// GetStartGroup is a wrapper around g_key_file_get_start_group().
+func (v *KeyFile) GetStartGroup() string {
+	c := C.g_key_file_get_start_group(v.native())
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c)
+}
+
+// GetGroups is a wrapper around g_key_file_get_groups().
+func (v *KeyFile) GetGroups() []string {
+	c := C.g_key_file_get_groups(v.native(), nil)
+	defer C.g_strfreev(c)
+
+	return goStringArray(c)
+}
+
+// GetKeys is a wrapper around g_key_file_get_keys().
+func (v *KeyFile) GetKeys(group string) ([]string, error) {
+	cstr := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_get_keys(v.native(), cstr, nil, &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	defer C.g_strfreev(c)
+
+	return goStringArray(c), nil
+}
+
+// HasGroup is a wrapper around g_key_file_has_group().
+func (v *KeyFile) HasGroup(group string) bool {
+	cstr := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr))
+
+	return gobool(C.g_key_file_has_group(v.native(), cstr))
+}
+
+// HasKey is a wrapper around g_key_file_has_key().
+// An error is returned if group does not exist.
+func (v *KeyFile) HasKey(group, key string) (bool, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_has_key(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return false, takeError(err)
+	}
+	return gobool(c), nil
+}
+
+// RemoveGroup is a wrapper around g_key_file_remove_group().
+func (v *KeyFile) RemoveGroup(group string) error {
+	cstr := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_key_file_remove_group(v.native(), cstr, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// RemoveKey is a wrapper around g_key_file_remove_key().
+func (v *KeyFile) RemoveKey(group, key string) error {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_remove_key(v.native(), cstr1, cstr2, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// GetValue is a wrapper around g_key_file_get_value().
+// The raw value is returned, without unescaping.
+func (v *KeyFile) GetValue(group, key string) (string, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_value(v.native(), cstr1, cstr2, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c), nil
+}
+
+// SetValue is a wrapper around g_key_file_set_value().
+// The raw value is stored, without escaping.
+func (v *KeyFile) SetValue(group, key, value string) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	cstr3 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr3))
+
+	C.g_key_file_set_value(v.native(), cstr1, cstr2, cstr3)
+}
+
+// GetString is a wrapper around g_key_file_get_string().
+func (v *KeyFile) GetString(group, key string) (string, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_string(v.native(), cstr1, cstr2, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c), nil
+}
+
+// SetString is a wrapper around g_key_file_set_string().
+func (v *KeyFile) SetString(group, key, value string) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	cstr3 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr3))
+
+	C.g_key_file_set_string(v.native(), cstr1, cstr2, cstr3)
+}
+
+// GetLocaleString is a wrapper around g_key_file_get_locale_string().
+// If locale is empty, the value for the current locale is returned;
+// the untranslated value is returned if no translation matches.
+func (v *KeyFile) GetLocaleString(group, key, locale string) (string, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var cstr3 *C.gchar
+	if locale != "" {
+		cstr3 = (*C.gchar)(C.CString(locale))
+		defer C.free(unsafe.Pointer(cstr3))
+	}
+
+	var err *C.GError
+	c := C.g_key_file_get_locale_string(v.native(), cstr1, cstr2, cstr3, &err)
+	if c == nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c), nil
+}
+
+// SetLocaleString is a wrapper around g_key_file_set_locale_string().
+func (v *KeyFile) SetLocaleString(group, key, locale, value string) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	cstr3 := C.CString(locale)
+	defer C.free(unsafe.Pointer(cstr3))
+
+	cstr4 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr4))
+
+	C.g_key_file_set_locale_string(v.native(), cstr1, cstr2, cstr3, cstr4)
+}
+
+// GetBoolean is a wrapper around g_key_file_get_boolean().
+func (v *KeyFile) GetBoolean(group, key string) (bool, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_boolean(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return false, takeError(err)
+	}
+	return gobool(c), nil
+}
+
+// SetBoolean is a wrapper around g_key_file_set_boolean().
+func (v *KeyFile) SetBoolean(group, key string, value bool) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_key_file_set_boolean(v.native(), cstr1, cstr2, gbool(value))
+}
+
+// GetInteger is a wrapper around g_key_file_get_integer().
+func (v *KeyFile) GetInteger(group, key string) (int, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_integer(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int(c), nil
+}
+
+// SetInteger is a wrapper around g_key_file_set_integer().
+func (v *KeyFile) SetInteger(group, key string, value int) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_key_file_set_integer(v.native(), cstr1, cstr2, C.gint(value))
+}
+
+// GetInt64 is a wrapper around g_key_file_get_int64().
+func (v *KeyFile) GetInt64(group, key string) (int64, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_int64(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int64(c), nil
+}
+
+// SetInt64 is a wrapper around g_key_file_set_int64().
+func (v *KeyFile) SetInt64(group, key string, value int64) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_key_file_set_int64(v.native(), cstr1, cstr2, C.gint64(value))
+}
+
+// GetUint64 is a wrapper around g_key_file_get_uint64().
+func (v *KeyFile) GetUint64(group, key string) (uint64, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_uint64(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return uint64(c), nil
+}
+
+// SetUint64 is a wrapper around g_key_file_set_uint64().
+func (v *KeyFile) SetUint64(group, key string, value uint64) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_key_file_set_uint64(v.native(), cstr1, cstr2, C.guint64(value))
+}
+
+// GetDouble is a wrapper around g_key_file_get_double().
+func (v *KeyFile) GetDouble(group, key string) (float64, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_double(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return float64(c), nil
+}
+
+// SetDouble is a wrapper around g_key_file_set_double().
+func (v *KeyFile) SetDouble(group, key string, value float64) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_key_file_set_double(v.native(), cstr1, cstr2, C.gdouble(value))
+}
+
+// GetStringList is a wrapper around g_key_file_get_string_list().
+func (v *KeyFile) GetStringList(group, key string) ([]string, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var err *C.GError
+	c := C.g_key_file_get_string_list(v.native(), cstr1, cstr2, nil, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_strfreev(c)
+
+	return goStringArray(c), nil
+}
+
+// SetStringList is a wrapper around g_key_file_set_string_list().
+func (v *KeyFile) SetStringList(group, key string, list []string) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	clist := C.make_strings(C.int(len(list) + 1))
+	defer C.destroy_strings(clist)
+
+	for i, str := range list {
+		cstr := C.CString(str)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(clist, C.int(i), cstr)
+	}
+	C.set_string(clist, C.int(len(list)), nil)
+
+	C.g_key_file_set_string_list(v.native(), cstr1, cstr2,
+		(**C.gchar)(unsafe.Pointer(clist)), C.gsize(len(list)))
+}
+
+// GetLocaleStringList is a wrapper around g_key_file_get_locale_string_list().
+// If locale is empty, the list for the current locale is returned.
+func (v *KeyFile) GetLocaleStringList(group, key, locale string) ([]string, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var cstr3 *C.gchar
+	if locale != "" {
+		cstr3 = (*C.gchar)(C.CString(locale))
+		defer C.free(unsafe.Pointer(cstr3))
+	}
+
+	var err *C.GError
+	c := C.g_key_file_get_locale_string_list(v.native(), cstr1, cstr2, cstr3, nil, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_strfreev(c)
+
+	return goStringArray(c), nil
+}
+
+// SetLocaleStringList is a wrapper around g_key_file_set_locale_string_list().
+func (v *KeyFile) SetLocaleStringList(group, key, locale string, list []string) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	cstr3 := C.CString(locale)
+	defer C.free(unsafe.Pointer(cstr3))
+
+	clist := C.make_strings(C.int(len(list) + 1))
+	defer C.destroy_strings(clist)
+
+	for i, str := range list {
+		cstr := C.CString(str)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(clist, C.int(i), cstr)
+	}
+	C.set_string(clist, C.int(len(list)), nil)
+
+	C.g_key_file_set_locale_string_list(v.native(), cstr1, cstr2, cstr3,
+		(**C.gchar)(unsafe.Pointer(clist)), C.gsize(len(list)))
+}
+
+// GetBooleanList is a wrapper around g_key_file_get_boolean_list().
+func (v *KeyFile) GetBooleanList(group, key string) ([]bool, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_get_boolean_list(v.native(), cstr1, cstr2, &length, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	list := make([]bool, int(length))
+	if length > 0 {
+		values := (*[1 << 28]C.gboolean)(unsafe.Pointer(c))[:length:length]
+		for i, value := range values {
+			list[i] = gobool(value)
+		}
+	}
+	return list, nil
+}
+
+// SetBooleanList is a wrapper around g_key_file_set_boolean_list().
+func (v *KeyFile) SetBooleanList(group, key string, list []bool) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	values := make([]C.gboolean, len(list)+1)
+	for i, value := range list {
+		values[i] = gbool(value)
+	}
+	C.g_key_file_set_boolean_list(v.native(), cstr1, cstr2, &values[0], C.gsize(len(list)))
+}
+
+// GetIntegerList is a wrapper around g_key_file_get_integer_list().
+func (v *KeyFile) GetIntegerList(group, key string) ([]int, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_get_integer_list(v.native(), cstr1, cstr2, &length, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	list := make([]int, int(length))
+	if length > 0 {
+		values := (*[1 << 28]C.gint)(unsafe.Pointer(c))[:length:length]
+		for i, value := range values {
+			list[i] = int(value)
+		}
+	}
+	return list, nil
+}
+
+// SetIntegerList is a wrapper around g_key_file_set_integer_list().
+func (v *KeyFile) SetIntegerList(group, key string, list []int) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	values := make([]C.gint, len(list)+1)
+	for i, value := range list {
+		values[i] = C.gint(value)
+	}
+	C.g_key_file_set_integer_list(v.native(), cstr1, cstr2, &values[0], C.gsize(len(list)))
+}
+
+// GetDoubleList is a wrapper around g_key_file_get_double_list().
+func (v *KeyFile) GetDoubleList(group, key string) ([]float64, error) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_key_file_get_double_list(v.native(), cstr1, cstr2, &length, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	list := make([]float64, int(length))
+	if length > 0 {
+		values := (*[1 << 28]C.gdouble)(unsafe.Pointer(c))[:length:length]
+		for i, value := range values {
+			list[i] = float64(value)
+		}
+	}
+	return list, nil
+}
+
+// SetDoubleList is a wrapper around g_key_file_set_double_list().
+func (v *KeyFile) SetDoubleList(group, key string, list []float64) {
+	cstr1 := C.CString(group)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	values := make([]C.gdouble, len(list)+1)
+	for i, value := range list {
+		values[i] = C.gdouble(value)
+	}
+	C.g_key_file_set_double_list(v.native(), cstr1, cstr2, &values[0], C.gsize(len(list)))
+}
+
+// GetComment is a wrapper around g_key_file_get_comment().
+// If key is empty, the comment above group is returned; if group is
+// also empty, the comment at the top of the file is returned.
+func (v *KeyFile) GetComment(group, key string) (string, error) {
+	var cstr1, cstr2 *C.gchar
+	if group != "" {
+		cstr1 = (*C.gchar)(C.CString(group))
+		defer C.free(unsafe.Pointer(cstr1))
+	}
+	if key != "" {
+		cstr2 = (*C.gchar)(C.CString(key))
+		defer C.free(unsafe.Pointer(cstr2))
+	}
+
+	var err *C.GError
+	c := C.g_key_file_get_comment(v.native(), cstr1, cstr2, &err)
+	if err != nil {
+		return "", takeError(err)
+	}
+	defer C.g_free(C.gpointer(c))
+
+	return goString(c), nil
+}
+
+// SetComment is a wrapper around g_key_file_set_comment().
+// group and key select the comment as in GetComment. Each line of
+// comment is written prefixed with '#'.
+func (v *KeyFile) SetComment(group, key, comment string) error {
+	var cstr1, cstr2 *C.gchar
+	if group != "" {
+		cstr1 = (*C.gchar)(C.CString(group))
+		defer C.free(unsafe.Pointer(cstr1))
+	}
+	if key != "" {
+		cstr2 = (*C.gchar)(C.CString(key))
+		defer C.free(unsafe.Pointer(cstr2))
+	}
+
+	cstr3 := C.CString(comment)
+	defer C.free(unsafe.Pointer(cstr3))
+
+	var err *C.GError
+	c := C.g_key_file_set_comment(v.native(), cstr1, cstr2, cstr3, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// RemoveComment is a wrapper around g_key_file_remove_comment().
+// group and key select the comment as in GetComment.
+func (v *KeyFile) RemoveComment(group, key string) error {
+	var cstr1, cstr2 *C.gchar
+	if group != "" {
+		cstr1 = (*C.gchar)(C.CString(group))
+		defer C.free(unsafe.Pointer(cstr1))
+	}
+	if key != "" {
+		cstr2 = (*C.gchar)(C.CString(key))
+		defer C.free(unsafe.Pointer(cstr2))
+	}
+
+	var err *C.GError
+	c := C.g_key_file_remove_comment(v.native(), cstr1, cstr2, &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_key_file_get_type()), marshalKeyFile},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,102 @@
+package glib_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+const testDesktopEntry = `# A launcher
+[Desktop Entry]
+Type=Application
+Name=Example
+Name[fr]=Exemple
+Exec=example %U
+Terminal=false
+Categories=Utility;Development;
+`
+
+func TestKeyFile_LoadFromData(t *testing.T) {
+	keyFile := glib.KeyFileNew()
+	err := keyFile.LoadFromData(testDesktopEntry,
+		glib.KEY_FILE_KEEP_COMMENTS|glib.KEY_FILE_KEEP_TRANSLATIONS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group := keyFile.GetStartGroup(); group != glib.KEY_FILE_DESKTOP_GROUP {
+		t.Errorf("Expected start group %q, got %q", glib.KEY_FILE_DESKTOP_GROUP, group)
+	}
+
+	name, err := keyFile.GetLocaleString(glib.KEY_FILE_DESKTOP_GROUP,
+		glib.KEY_FILE_DESKTOP_KEY_NAME, "fr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Exemple" {
+		t.Errorf("Expected localized name %q, got %q", "Exemple", name)
+	}
+
+	terminal, err := keyFile.GetBoolean(glib.KEY_FILE_DESKTOP_GROUP,
+		glib.KEY_FILE_DESKTOP_KEY_TERMINAL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if terminal {
+		t.Error("Expected Terminal to be false")
+	}
+
+	categories, err := keyFile.GetStringList(glib.KEY_FILE_DESKTOP_GROUP,
+		glib.KEY_FILE_DESKTOP_KEY_CATEGORIES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"Utility", "Development"}; !reflect.DeepEqual(categories, expected) {
+		t.Errorf("Expected categories %v, got %v", expected, categories)
+	}
+
+	comment, err := keyFile.GetComment("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comment != " A launcher" {
+		t.Errorf("Expected comment %q, got %q", " A launcher", comment)
+	}
+
+	_, err = keyFile.GetString(glib.KEY_FILE_DESKTOP_GROUP, "Missing")
+	if !errors.Is(err, glib.ErrKeyFileKeyNotFound) {
+		t.Errorf("Expected a KEY_NOT_FOUND error, got %v", err)
+	}
+}
+
+func TestKeyFile_ToData(t *testing.T) {
+	keyFile := glib.KeyFileNew()
+	keyFile.SetIntegerList("Settings", "Sizes", []int{16, 32})
+	keyFile.SetDouble("Settings", "Scale", 1.5)
+
+	data, err := keyFile.ToData()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := glib.KeyFileNew()
+	if err := loaded.LoadFromData(data, glib.KEY_FILE_NONE); err != nil {
+		t.Fatal(err)
+	}
+	sizes, err := loaded.GetIntegerList("Settings", "Sizes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{16, 32}; !reflect.DeepEqual(sizes, expected) {
+		t.Errorf("Expected sizes %v, got %v", expected, sizes)
+	}
+	scale, err := loaded.GetDouble("Settings", "Scale")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scale != 1.5 {
+		t.Errorf("Expected scale 1.5, got %v", scale)
+	}
+}
@@ -0,0 +1,327 @@
+// +build !glib_2_40,!glib_2_42
+
+// See: https://developer.gnome.org/glib/2.44/api-index-2-44.html
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "list_model_since_2_44.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+)
+
+/*
+ * GListModel
+ */
+
+// IListModel is an interface type implemented by all structs
+// embedding a ListModel.
+type IListModel interface {
+	toListModel() *C.GListModel
+	// Use this method to expose access to GLIB underlying object
+	// in external packages.
+	Native() uintptr
+}
+
+// ListModel is a representation of GListModel GInterface.
+type ListModel struct {
+	*Object
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var _ IListModel = &ListModel{}
+
+// native() returns a pointer to the underlying GListModel.
+func (v *ListModel) native() *C.GListModel {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGListModel(ptr)
+}
+
+func (v *ListModel) toListModel() *C.GListModel {
+	return v.native()
+}
+
+func (v *ListModel) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func marshalListModel(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapListModel(wrapObject(unsafe.Pointer(c))), nil
+}
+
+func wrapListModel(obj *Object) *ListModel {
+	return &ListModel{obj}
+}
+
+// GetItemType is a wrapper around g_list_model_get_item_type().
+func (v *ListModel) GetItemType() Type {
+	return Type(C.g_list_model_get_item_type(v.native()))
+}
+
+// GetNItems is a wrapper around g_list_model_get_n_items().
+func (v *ListModel) GetNItems() uint {
+	return uint(C.g_list_model_get_n_items(v.native()))
+}
+
+// GetItem is a wrapper around g_list_model_get_object().
+// nil is returned if position is out of range.
+func (v *ListModel) GetItem(position uint) *Object {
+	c := C.g_list_model_get_object(v.native(), C.guint(position))
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return obj
+}
+
+// ItemsChanged is a wrapper around g_list_model_items_changed().
+// Go implementations of ListModel must call it whenever their
+// items change: removed items at position were replaced by added ones.
+func (v *ListModel) ItemsChanged(position, removed, added uint) {
+	C.g_list_model_items_changed(v.native(), C.guint(position), C.guint(removed),
+		C.guint(added))
+}
+
+// ConnectItemsChanged connects f to the "items-changed" signal
+// of the model.
+func (v *ListModel) ConnectItemsChanged(f func(position, removed, added uint)) (SignalHandle, error) {
+	// The instance may be of any type implementing GListModel.
+	return v.Connect("items-changed", func(_ interface{}, position, removed, added uint) {
+		f(position, removed, added)
+	})
+}
+
+// ListModelImplementation is implemented by Go values providing the
+// items of a ListModel created with ListModelNew.
+type ListModelImplementation interface {
+	// GetNItems returns the number of items in the model.
+	GetNItems() uint
+	// GetItem returns the item at position, which is an object of the
+	// item type of the model, or nil if position is out of range.
+	GetItem(position uint) IObject
+}
+
+var (
+	listModelRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]ListModelImplementation
+	}{
+		next: 1,
+		m:    make(map[int]ListModelImplementation),
+	}
+)
+
+// ListModelNew creates a ListModel whose items are provided by impl,
+// which lets widgets bound to a model display Go data without copying
+// it into a ListStore. Items must be objects of type itemType. impl
+// must report every change of its items by calling ItemsChanged on the
+// returned model, from the main loop.
+func ListModelNew(itemType Type, impl ListModelImplementation) (*ListModel, error) {
+	listModelRegistry.Lock()
+	id := listModelRegistry.next
+	listModelRegistry.next++
+	listModelRegistry.m[id] = impl
+	listModelRegistry.Unlock()
+
+	c := C._gotk3_list_model_new(C.GType(itemType), C.gpointer(uintptr(id)))
+	if c == nil {
+		goListModelFinalize(C.gpointer(uintptr(id)))
+		return nil, errNilPtr
+	}
+
+	obj := wrapObject(unsafe.Pointer(c))
+	// The model is created with a full reference,
+	// which is released once it is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapListModel(obj), nil
+}
+
+//export goListModelGetNItems
+func goListModelGetNItems(id C.gpointer) C.guint {
+	listModelRegistry.RLock()
+	impl := listModelRegistry.m[int(uintptr(id))]
+	listModelRegistry.RUnlock()
+
+	if impl == nil {
+		return 0
+	}
+	return C.guint(impl.GetNItems())
+}
+
+//export goListModelGetItem
+func goListModelGetItem(id C.gpointer, position C.guint) C.gpointer {
+	listModelRegistry.RLock()
+	impl := listModelRegistry.m[int(uintptr(id))]
+	listModelRegistry.RUnlock()
+
+	if impl == nil {
+		return nil
+	}
+	item := impl.GetItem(uint(position))
+	if item == nil || item.toObject() == nil {
+		return nil
+	}
+	// get_item() returns a full reference.
+	c := item.toObject().native()
+	C.g_object_ref(C.gpointer(c))
+	return C.gpointer(c)
+}
+
+//export goListModelFinalize
+func goListModelFinalize(id C.gpointer) {
+	listModelRegistry.Lock()
+	delete(listModelRegistry.m, int(uintptr(id)))
+	listModelRegistry.Unlock()
+}
+
+/*
+ * GListStore
+ */
+
+// ListStore is a representation of GListStore.
+type ListStore struct {
+	ListModel
+}
+
+// native() returns a pointer to the underlying GListStore.
+func (v *ListStore) native() *C.GListStore {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGListStore(ptr)
+}
+
+func marshalListStore(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapListStore(wrapObject(unsafe.Pointer(c))), nil
+}
+
+func wrapListStore(obj *Object) *ListStore {
+	return &ListStore{ListModel{obj}}
+}
+
+// ListStoreNew is a wrapper around g_list_store_new().
+// itemType must be an object type.
+func ListStoreNew(itemType Type) (*ListStore, error) {
+	c := C.g_list_store_new(C.GType(itemType))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// g_list_store_new() returns a full reference,
+	// which is released once the store is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapListStore(obj), nil
+}
+
+// Insert is a wrapper around g_list_store_insert().
+func (v *ListStore) Insert(position uint, item IObject) {
+	C.g_list_store_insert(v.native(), C.guint(position), C.gpointer(item.toObject().native()))
+}
+
+// Append is a wrapper around g_list_store_append().
+func (v *ListStore) Append(item IObject) {
+	C.g_list_store_append(v.native(), C.gpointer(item.toObject().native()))
+}
+
+// Remove is a wrapper around g_list_store_remove().
+func (v *ListStore) Remove(position uint) {
+	C.g_list_store_remove(v.native(), C.guint(position))
+}
+
+// RemoveAll is a wrapper around g_list_store_remove_all().
+func (v *ListStore) RemoveAll() {
+	C.g_list_store_remove_all(v.native())
+}
+
+// Splice is a wrapper around g_list_store_splice().
+// It removes nRemovals items at position and inserts additions in
+// their place, emitting a single "items-changed" signal, which is
+// much cheaper than inserting items one at a time.
+func (v *ListStore) Splice(position, nRemovals uint, additions []IObject) {
+	var cadditions *C.gpointer
+	if len(additions) > 0 {
+		items := make([]C.gpointer, len(additions))
+		for i, item := range additions {
+			items[i] = C.gpointer(item.toObject().native())
+		}
+		cadditions = &items[0]
+	}
+	C.g_list_store_splice(v.native(), C.guint(position), C.guint(nRemovals),
+		cadditions, C.guint(len(additions)))
+}
+
+// ListStoreCompareFunc compares two items of a ListStore; it returns a
+// negative value if a sorts before b, zero if they are equal, and a
+// positive value if a sorts after b.
+type ListStoreCompareFunc func(a, b *Object) int
+
+var (
+	listStoreCompareRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]ListStoreCompareFunc
+	}{
+		next: 1,
+		m:    make(map[int]ListStoreCompareFunc),
+	}
+)
+
+// registerListStoreCompareFunc stores compare for the duration of a
+// call and returns its user data, along with a func to release it.
+func registerListStoreCompareFunc(compare ListStoreCompareFunc) (C.gpointer, func()) {
+	listStoreCompareRegistry.Lock()
+	id := listStoreCompareRegistry.next
+	listStoreCompareRegistry.next++
+	listStoreCompareRegistry.m[id] = compare
+	listStoreCompareRegistry.Unlock()
+
+	return C.gpointer(uintptr(id)), func() {
+		listStoreCompareRegistry.Lock()
+		delete(listStoreCompareRegistry.m, id)
+		listStoreCompareRegistry.Unlock()
+	}
+}
+
+//export goListStoreCompare
+func goListStoreCompare(a, b C.gconstpointer, userData C.gpointer) C.gint {
+	listStoreCompareRegistry.RLock()
+	fn := listStoreCompareRegistry.m[int(uintptr(userData))]
+	listStoreCompareRegistry.RUnlock()
+
+	return C.gint(fn(wrapObject(unsafe.Pointer(a)), wrapObject(unsafe.Pointer(b))))
+}
+
+// InsertSorted is a wrapper around g_list_store_insert_sorted().
+// The position of the inserted item is returned.
+func (v *ListStore) InsertSorted(item IObject, compare ListStoreCompareFunc) uint {
+	data, release := registerListStoreCompareFunc(compare)
+	defer release()
+
+	c := C.g_list_store_insert_sorted(v.native(), C.gpointer(item.toObject().native()),
+		C._go_list_store_compare_func(), data)
+	return uint(c)
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_list_model_get_type()), marshalListModel},
+		{Type(C.g_list_store_get_type()), marshalListStore},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,123 @@
+// Same copyright and license as the rest of the files in this project
+
+// GListModel, GListStore
+// See: https://developer.gnome.org/gio/stable/GListModel.html
+
+#ifndef __GLIST_MODEL_GO_H__
+#define __GLIST_MODEL_GO_H__
+
+#include <gio/gio.h>
+
+extern guint goListModelGetNItems(gpointer id);
+extern gpointer goListModelGetItem(gpointer id, guint position);
+extern void goListModelFinalize(gpointer id);
+extern gint goListStoreCompare(gconstpointer a, gconstpointer b,
+                               gpointer user_data);
+
+static GListModel *
+toGListModel(void *p)
+{
+	return (G_LIST_MODEL(p));
+}
+
+static GListStore *
+toGListStore(void *p)
+{
+	return (G_LIST_STORE(p));
+}
+
+static GCompareDataFunc
+_go_list_store_compare_func(void)
+{
+	return ((GCompareDataFunc)goListStoreCompare);
+}
+
+/*
+ * Gotk3ListModel is a GListModel implemented by a Go value,
+ * which is referenced by id from the Go side.
+ */
+
+typedef struct {
+	GObject parent_instance;
+	GType item_type;
+	gpointer id;
+} Gotk3ListModel;
+
+typedef struct {
+	GObjectClass parent_class;
+} Gotk3ListModelClass;
+
+static GObjectClass *gotk3_list_model_parent_class = NULL;
+
+static GType
+gotk3_list_model_get_item_type(GListModel *list)
+{
+	return (((Gotk3ListModel *)list)->item_type);
+}
+
+static guint
+gotk3_list_model_get_n_items(GListModel *list)
+{
+	return (goListModelGetNItems(((Gotk3ListModel *)list)->id));
+}
+
+static gpointer
+gotk3_list_model_get_item(GListModel *list, guint position)
+{
+	return (goListModelGetItem(((Gotk3ListModel *)list)->id, position));
+}
+
+static void
+gotk3_list_model_iface_init(gpointer g_iface, gpointer iface_data)
+{
+	GListModelInterface *iface = g_iface;
+
+	iface->get_item_type = gotk3_list_model_get_item_type;
+	iface->get_n_items = gotk3_list_model_get_n_items;
+	iface->get_item = gotk3_list_model_get_item;
+}
+
+static void
+gotk3_list_model_finalize(GObject *object)
+{
+	goListModelFinalize(((Gotk3ListModel *)object)->id);
+	gotk3_list_model_parent_class->finalize(object);
+}
+
+static void
+gotk3_list_model_class_init(gpointer g_class, gpointer class_data)
+{
+	gotk3_list_model_parent_class = g_type_class_peek_parent(g_class);
+	G_OBJECT_CLASS(g_class)->finalize = gotk3_list_model_finalize;
+}
+
+static GType
+gotk3_list_model_get_type(void)
+{
+	static gsize type_id = 0;
+
+	if (g_once_init_enter(&type_id)) {
+		const GInterfaceInfo iface_info = {
+			gotk3_list_model_iface_init, NULL, NULL
+		};
+		GType t = g_type_register_static_simple(G_TYPE_OBJECT,
+			g_intern_static_string("Gotk3ListModel"),
+			sizeof(Gotk3ListModelClass), gotk3_list_model_class_init,
+			sizeof(Gotk3ListModel), NULL, 0);
+		g_type_add_interface_static(t, G_TYPE_LIST_MODEL, &iface_info);
+		g_once_init_leave(&type_id, t);
+	}
+	return (type_id);
+}
+
+static GListModel *
+_gotk3_list_model_new(GType item_type, gpointer id)
+{
+	Gotk3ListModel *self = g_object_new(gotk3_list_model_get_type(), NULL);
+
+	self->item_type = item_type;
+	self->id = id;
+	return (G_LIST_MODEL(self));
+}
+
+#endif
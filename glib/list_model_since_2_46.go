@@ -0,0 +1,21 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44
+
+// See: https://developer.gnome.org/glib/2.46/api-index-2-46.html
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "list_model_since_2_44.go.h"
+import "C"
+
+// Sort is a wrapper around g_list_store_sort().
+func (v *ListStore) Sort(compare ListStoreCompareFunc) {
+	data, release := registerListStoreCompareFunc(compare)
+	defer release()
+
+	C.g_list_store_sort(v.native(), C._go_list_store_compare_func(), data)
+}
@@ -0,0 +1,33 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44
+
+package glib_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestListStore_Sort(t *testing.T) {
+	store, err := glib.ListStoreNew(glib.TYPE_OBJECT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, action := range newNamedActions(t, "quit", "copy", "paste", "cut") {
+		store.Append(action)
+	}
+
+	var changes [][3]uint
+	store.ConnectItemsChanged(func(position, removed, added uint) {
+		changes = append(changes, [3]uint{position, removed, added})
+	})
+	store.Sort(compareActionNames)
+
+	if got, want := storeActionNames(store), []string{"copy", "cut", "paste", "quit"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sort: got %v, want %v", got, want)
+	}
+	if want := [][3]uint{{0, 4, 4}}; !reflect.DeepEqual(changes, want) {
+		t.Errorf("items-changed: got %v, want %v", changes, want)
+	}
+}
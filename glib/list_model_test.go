@@ -0,0 +1,144 @@
+// +build !glib_2_40,!glib_2_42
+
+package glib_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestListStore_Splice(t *testing.T) {
+	store, err := glib.ListStoreNew(glib.TYPE_OBJECT)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var changes [][3]uint
+	store.ConnectItemsChanged(func(position, removed, added uint) {
+		changes = append(changes, [3]uint{position, removed, added})
+	})
+
+	var items []glib.IObject
+	for i := 0; i < 3; i++ {
+		action, err := glib.SimpleActionNew("action", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, action)
+	}
+
+	store.Append(items[0])
+	store.Splice(0, 1, items)
+
+	if n := store.GetNItems(); n != 3 {
+		t.Errorf("GetNItems: got %d", n)
+	}
+	if store.GetItem(3) != nil {
+		t.Error("GetItem out of range must return nil")
+	}
+	want := [][3]uint{{0, 0, 1}, {0, 1, 3}}
+	if len(changes) != len(want) || changes[0] != want[0] || changes[1] != want[1] {
+		t.Errorf("items-changed: got %v", changes)
+	}
+}
+
+// newNamedActions returns actions with the given names, to be sorted by
+// compareActionNames.
+func newNamedActions(t *testing.T, names ...string) []*glib.SimpleAction {
+	t.Helper()
+	var actions []*glib.SimpleAction
+	for _, name := range names {
+		action, err := glib.SimpleActionNew(name, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+func actionName(obj *glib.Object) string {
+	name, _ := obj.GetProperty("name")
+	s, _ := name.(string)
+	return s
+}
+
+func compareActionNames(a, b *glib.Object) int {
+	return strings.Compare(actionName(a), actionName(b))
+}
+
+// storeActionNames returns the names of the actions in store, in order.
+func storeActionNames(store *glib.ListStore) []string {
+	var names []string
+	for i := uint(0); i < store.GetNItems(); i++ {
+		names = append(names, actionName(store.GetItem(i)))
+	}
+	return names
+}
+
+func TestListStore_InsertSorted(t *testing.T) {
+	store, err := glib.ListStoreNew(glib.TYPE_OBJECT)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var positions []uint
+	for _, action := range newNamedActions(t, "paste", "copy", "quit", "cut") {
+		positions = append(positions, store.InsertSorted(action, compareActionNames))
+	}
+
+	if want := []uint{0, 0, 2, 1}; !reflect.DeepEqual(positions, want) {
+		t.Errorf("InsertSorted: got positions %v, want %v", positions, want)
+	}
+	if got, want := storeActionNames(store), []string{"copy", "cut", "paste", "quit"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InsertSorted: got %v, want %v", got, want)
+	}
+}
+
+type testListModel struct {
+	items []*glib.SimpleAction
+}
+
+func (m *testListModel) GetNItems() uint {
+	return uint(len(m.items))
+}
+
+func (m *testListModel) GetItem(position uint) glib.IObject {
+	if position >= uint(len(m.items)) {
+		return nil
+	}
+	return m.items[position]
+}
+
+func TestListModelNew(t *testing.T) {
+	impl := &testListModel{}
+	for i := 0; i < 2; i++ {
+		action, err := glib.SimpleActionNew("action", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		impl.items = append(impl.items, action)
+	}
+
+	model, err := glib.ListModelNew(glib.TYPE_OBJECT, impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := model.GetNItems(); n != 2 {
+		t.Errorf("GetNItems: got %d", n)
+	}
+	if model.GetItemType() != glib.TYPE_OBJECT {
+		t.Errorf("GetItemType: got %v", model.GetItemType())
+	}
+	item := model.GetItem(1)
+	if item == nil || item.Native() != impl.items[1].Native() {
+		t.Error("GetItem must return the item of the implementation")
+	}
+	if model.GetItem(2) != nil {
+		t.Error("GetItem out of range must return nil")
+	}
+}
@@ -0,0 +1,192 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "log.go.h"
+import "C"
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unsafe"
+)
+
+// LogLevelFlags is a representation of GLib's GLogLevelFlags.
+type LogLevelFlags int
+
+const (
+	LOG_FLAG_RECURSION LogLevelFlags = C.G_LOG_FLAG_RECURSION
+	LOG_FLAG_FATAL     LogLevelFlags = C.G_LOG_FLAG_FATAL
+
+	LOG_LEVEL_ERROR    LogLevelFlags = C.G_LOG_LEVEL_ERROR
+	LOG_LEVEL_CRITICAL LogLevelFlags = C.G_LOG_LEVEL_CRITICAL
+	LOG_LEVEL_WARNING  LogLevelFlags = C.G_LOG_LEVEL_WARNING
+	LOG_LEVEL_MESSAGE  LogLevelFlags = C.G_LOG_LEVEL_MESSAGE
+	LOG_LEVEL_INFO     LogLevelFlags = C.G_LOG_LEVEL_INFO
+	LOG_LEVEL_DEBUG    LogLevelFlags = C.G_LOG_LEVEL_DEBUG
+
+	LOG_LEVEL_MASK LogLevelFlags = C.G_LOG_LEVEL_MASK
+)
+
+var logLevelNames = []struct {
+	level LogLevelFlags
+	name  string
+}{
+	{LOG_LEVEL_ERROR, "ERROR"},
+	{LOG_LEVEL_CRITICAL, "CRITICAL"},
+	{LOG_LEVEL_WARNING, "WARNING"},
+	{LOG_LEVEL_MESSAGE, "MESSAGE"},
+	{LOG_LEVEL_INFO, "INFO"},
+	{LOG_LEVEL_DEBUG, "DEBUG"},
+}
+
+// String returns the names of the levels of l, like "CRITICAL", as
+// printed by GLib.
+func (l LogLevelFlags) String() string {
+	var names []string
+	for _, n := range logLevelNames {
+		if l&n.level != 0 {
+			names = append(names, n.name)
+		}
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("LogLevelFlags(%d)", int(l))
+	}
+	return strings.Join(names, "|")
+}
+
+/*
+ * Log handlers
+ */
+
+// LogFunc is the Go representation of GLib's GLogFunc.
+type LogFunc func(domain string, level LogLevelFlags, message string)
+
+var (
+	logHandlerRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]LogFunc
+		ids  map[uint]int
+	}{
+		next: 1,
+		m:    make(map[int]LogFunc),
+		ids:  make(map[uint]int),
+	}
+)
+
+// LogSetHandler is a wrapper around g_log_set_handler(). f is called with
+// the messages of the given levels logged by the domain, or by the
+// default domain, the one of applications, if domain is empty.
+// Messages handled by f are no longer passed to the log writer.
+func LogSetHandler(domain string, levels LogLevelFlags, f LogFunc) uint {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+
+	logHandlerRegistry.Lock()
+	defer logHandlerRegistry.Unlock()
+	id := logHandlerRegistry.next
+	logHandlerRegistry.next++
+	logHandlerRegistry.m[id] = f
+
+	handlerID := uint(C.g_log_set_handler(cdomain, C.GLogLevelFlags(levels),
+		C._go_log_handler(), C.gpointer(uintptr(id))))
+	logHandlerRegistry.ids[handlerID] = id
+	return handlerID
+}
+
+// LogRemoveHandler is a wrapper around g_log_remove_handler().
+func LogRemoveHandler(domain string, handlerID uint) {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+
+	C.g_log_remove_handler(cdomain, C.guint(handlerID))
+
+	logHandlerRegistry.Lock()
+	delete(logHandlerRegistry.m, logHandlerRegistry.ids[handlerID])
+	delete(logHandlerRegistry.ids, handlerID)
+	logHandlerRegistry.Unlock()
+}
+
+//export goLogHandler
+func goLogHandler(domain *C.gchar, level C.GLogLevelFlags, message *C.gchar, userData C.gpointer) {
+	logHandlerRegistry.RLock()
+	f := logHandlerRegistry.m[int(uintptr(userData))]
+	logHandlerRegistry.RUnlock()
+
+	if f != nil {
+		f(goString(domain), LogLevelFlags(level), goString(message))
+	}
+}
+
+// LogSetAlwaysFatal is a wrapper around g_log_set_always_fatal().
+func LogSetAlwaysFatal(levels LogLevelFlags) LogLevelFlags {
+	return LogLevelFlags(C.g_log_set_always_fatal(C.GLogLevelFlags(levels)))
+}
+
+// LogSetFatalMask is a wrapper around g_log_set_fatal_mask().
+func LogSetFatalMask(domain string, levels LogLevelFlags) LogLevelFlags {
+	cdomain := C.CString(domain)
+	defer C.free(unsafe.Pointer(cdomain))
+
+	return LogLevelFlags(C.g_log_set_fatal_mask((*C.gchar)(cdomain), C.GLogLevelFlags(levels)))
+}
+
+/*
+ * Logging
+ */
+
+// Log is a wrapper around g_log(). It formats the message as fmt.Sprintf
+// does, and logs it through the same handlers and writer as the messages
+// of GLib and GTK. domain is the default domain if empty. Messages of the
+// LOG_LEVEL_ERROR level abort the program.
+func Log(domain string, level LogLevelFlags, format string, args ...interface{}) {
+	var cdomain *C.gchar
+	if domain != "" {
+		cdomain = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cdomain))
+	}
+	cmessage := C.CString(fmt.Sprintf(format, args...))
+	defer C.free(unsafe.Pointer(cmessage))
+
+	C._g_log(cdomain, C.GLogLevelFlags(level), (*C.gchar)(cmessage))
+}
+
+// LogCritical logs a message of the LOG_LEVEL_CRITICAL level, like
+// g_critical() does.
+func LogCritical(domain, format string, args ...interface{}) {
+	Log(domain, LOG_LEVEL_CRITICAL, format, args...)
+}
+
+// LogWarning logs a message of the LOG_LEVEL_WARNING level, like
+// g_warning() does.
+func LogWarning(domain, format string, args ...interface{}) {
+	Log(domain, LOG_LEVEL_WARNING, format, args...)
+}
+
+// LogMessage logs a message of the LOG_LEVEL_MESSAGE level, like
+// g_message() does.
+func LogMessage(domain, format string, args ...interface{}) {
+	Log(domain, LOG_LEVEL_MESSAGE, format, args...)
+}
+
+// LogInfo logs a message of the LOG_LEVEL_INFO level, like g_info() does.
+func LogInfo(domain, format string, args ...interface{}) {
+	Log(domain, LOG_LEVEL_INFO, format, args...)
+}
+
+// LogDebug logs a message of the LOG_LEVEL_DEBUG level, like g_debug()
+// does.
+func LogDebug(domain, format string, args ...interface{}) {
+	Log(domain, LOG_LEVEL_DEBUG, format, args...)
+}
@@ -0,0 +1,53 @@
+// Same copyright and license as the rest of the files in this project
+
+// Message logging
+// See: https://developer.gnome.org/glib/stable/glib-Message-Logging.html
+
+#ifndef __GLOG_GO_H__
+#define __GLOG_GO_H__
+
+#include <glib.h>
+
+extern void goLogHandler(gchar *log_domain, GLogLevelFlags log_level,
+                         gchar *message, gpointer user_data);
+
+static GLogFunc
+_go_log_handler(void)
+{
+	return ((GLogFunc)goLogHandler);
+}
+
+static void
+_g_log(const gchar *log_domain, GLogLevelFlags log_level, const gchar *message)
+{
+	g_log(log_domain, log_level, "%s", message);
+}
+
+#if GLIB_CHECK_VERSION(2, 50, 0)
+
+extern GLogWriterOutput goLogWriter(GLogLevelFlags log_level, GLogField *fields,
+                                    gsize n_fields, gpointer user_data);
+
+static GLogWriterFunc
+_go_log_writer(void)
+{
+	return ((GLogWriterFunc)goLogWriter);
+}
+
+static GLogField *
+alloc_log_fields(gsize n)
+{
+	return (g_new0(GLogField, n));
+}
+
+static void
+set_log_field(GLogField *fields, gsize i, const gchar *key, const gchar *value)
+{
+	fields[i].key = key;
+	fields[i].value = value;
+	fields[i].length = -1;
+}
+
+#endif
+
+#endif
@@ -0,0 +1,177 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+// See: https://developer.gnome.org/glib/2.50/api-index-2-50.html
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "log.go.h"
+import "C"
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// LogWriterOutput is a representation of GLib's GLogWriterOutput.
+type LogWriterOutput int
+
+const (
+	LOG_WRITER_HANDLED   LogWriterOutput = C.G_LOG_WRITER_HANDLED
+	LOG_WRITER_UNHANDLED LogWriterOutput = C.G_LOG_WRITER_UNHANDLED
+)
+
+// LogRecord is a structured log message, as received by a GLogWriterFunc.
+type LogRecord struct {
+	Level LogLevelFlags
+	// Domain is the GLIB_DOMAIN field, empty for the default domain.
+	Domain string
+	// Message is the MESSAGE field.
+	Message string
+	// Fields holds all the fields of the message, including GLIB_DOMAIN,
+	// MESSAGE, PRIORITY and the CODE_FILE, CODE_LINE and CODE_FUNC
+	// fields set by the C logging macros.
+	Fields map[string]string
+}
+
+// LogWriterFunc is the Go representation of GLib's GLogWriterFunc. It
+// returns LOG_WRITER_UNHANDLED to let the default writer print the
+// message.
+type LogWriterFunc func(record *LogRecord) LogWriterOutput
+
+var logWriter struct {
+	sync.RWMutex
+	once        sync.Once
+	f           LogWriterFunc
+	panicLevels LogLevelFlags
+}
+
+// installLogWriter sets goLogWriter as the writer of GLib, which may
+// only be done once by a program.
+func installLogWriter() {
+	logWriter.once.Do(func() {
+		C.g_log_set_writer_func(C._go_log_writer(), nil, nil)
+	})
+}
+
+// SetLogWriter forwards the structured log messages of GLib, GTK and of
+// the Log functions to f, or to the default writer, printing them to
+// the standard error or to the journal, if f is nil. This includes the
+// messages logged by g_log(), unless they are handled by a LogSetHandler
+// handler. f may be called from any thread, and must not log messages.
+//
+// SetLogWriter wraps g_log_set_writer_func(), which may only be called
+// once by a program, so it must not be used along with other libraries
+// setting the writer.
+func SetLogWriter(f LogWriterFunc) {
+	logWriter.Lock()
+	logWriter.f = f
+	logWriter.Unlock()
+
+	installLogWriter()
+}
+
+// SetLogPanicLevels makes the messages of the given levels panic once
+// they have been written, such as LOG_LEVEL_CRITICAL to catch
+// g_return_if_fail() failures, or 0 not to panic. It installs the same
+// writer as SetLogWriter. Tests may use glibtest.LogPanicOnCritical.
+//
+// The panic unwinds through the logging functions of GLib, so it must
+// not be recovered: GLib would then take every later message of the
+// thread for a recursive one, and print it without calling the writer.
+func SetLogPanicLevels(levels LogLevelFlags) {
+	logWriter.Lock()
+	logWriter.panicLevels = levels
+	logWriter.Unlock()
+
+	installLogWriter()
+}
+
+//export goLogWriter
+func goLogWriter(level C.GLogLevelFlags, fields *C.GLogField, nFields C.gsize,
+	userData C.gpointer) C.GLogWriterOutput {
+	logWriter.RLock()
+	f, panicLevels := logWriter.f, logWriter.panicLevels
+	logWriter.RUnlock()
+
+	record := &LogRecord{
+		Level:  LogLevelFlags(level),
+		Fields: make(map[string]string, int(nFields)),
+	}
+	for _, field := range (*[1 << 20]C.GLogField)(unsafe.Pointer(fields))[:nFields:nFields] {
+		var value string
+		if field.length < 0 {
+			value = C.GoString((*C.char)(field.value))
+		} else {
+			value = C.GoStringN((*C.char)(field.value), C.int(field.length))
+		}
+		record.Fields[C.GoString((*C.char)(field.key))] = value
+	}
+	record.Domain = record.Fields["GLIB_DOMAIN"]
+	record.Message = record.Fields["MESSAGE"]
+
+	output := LOG_WRITER_UNHANDLED
+	if f != nil {
+		output = f(record)
+	}
+	if output == LOG_WRITER_UNHANDLED {
+		output = LogWriterOutput(C.g_log_writer_default(level, fields, nFields, userData))
+	}
+
+	if record.Level&panicLevels != 0 {
+		// See SetLogPanicLevels about recovering.
+		prefix := (record.Level & LOG_LEVEL_MASK).String()
+		if record.Domain != "" {
+			prefix = record.Domain + "-" + prefix
+		}
+		panic(fmt.Sprintf("%s: %s", prefix, record.Message))
+	}
+	return C.GLogWriterOutput(output)
+}
+
+// logPriorities are the syslog priorities of the log levels, as set in
+// the PRIORITY field by GLib.
+var logPriorities = map[LogLevelFlags]string{
+	LOG_LEVEL_ERROR:    "3",
+	LOG_LEVEL_CRITICAL: "4",
+	LOG_LEVEL_WARNING:  "4",
+	LOG_LEVEL_MESSAGE:  "5",
+	LOG_LEVEL_INFO:     "6",
+	LOG_LEVEL_DEBUG:    "7",
+}
+
+// LogStructured is a wrapper around g_log_structured_array(). It logs
+// message with additional fields, whose keys are upper case, like
+// "REQUEST_ID". The GLIB_DOMAIN, MESSAGE and PRIORITY fields are set from
+// domain, which may be empty, message and level.
+func LogStructured(domain string, level LogLevelFlags, message string, fields map[string]string) {
+	all := map[string]string{
+		"MESSAGE":  message,
+		"PRIORITY": logPriorities[level&LOG_LEVEL_MASK],
+	}
+	if domain != "" {
+		all["GLIB_DOMAIN"] = domain
+	}
+	for key, value := range fields {
+		all[key] = value
+	}
+
+	cfields := C.alloc_log_fields(C.gsize(len(all)))
+	defer C.g_free(C.gpointer(cfields))
+
+	var i C.gsize
+	for key, value := range all {
+		ckey := C.CString(key)
+		defer C.free(unsafe.Pointer(ckey))
+		cvalue := C.CString(value)
+		defer C.free(unsafe.Pointer(cvalue))
+
+		C.set_log_field(cfields, i, (*C.gchar)(ckey), (*C.gchar)(cvalue))
+		i++
+	}
+
+	C.g_log_structured_array(C.GLogLevelFlags(level), cfields, i)
+}
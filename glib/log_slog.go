@@ -0,0 +1,62 @@
+// +build go1.21
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+)
+
+// logSlogLevels maps the log levels to the levels of log/slog.
+var logSlogLevels = map[LogLevelFlags]slog.Level{
+	LOG_LEVEL_ERROR:    slog.LevelError + 4,
+	LOG_LEVEL_CRITICAL: slog.LevelError,
+	LOG_LEVEL_WARNING:  slog.LevelWarn,
+	LOG_LEVEL_MESSAGE:  slog.LevelInfo,
+	LOG_LEVEL_INFO:     slog.LevelInfo,
+	LOG_LEVEL_DEBUG:    slog.LevelDebug,
+}
+
+// LogWriterSlog returns a LogWriterFunc forwarding the log messages to
+// handler, to be set with SetLogWriter:
+//
+//	glib.SetLogWriter(glib.LogWriterSlog(slog.Default().Handler()))
+//
+// Critical messages are logged at slog.LevelError, and error messages
+// above it. The domain of the messages is the "domain" attribute, and
+// the other fields, except MESSAGE and PRIORITY, are attributes named
+// after the lower case field, like "code_file".
+func LogWriterSlog(handler slog.Handler) LogWriterFunc {
+	return func(record *LogRecord) LogWriterOutput {
+		level := logSlogLevels[record.Level&LOG_LEVEL_MASK]
+		ctx := context.Background()
+		if !handler.Enabled(ctx, level) {
+			return LOG_WRITER_HANDLED
+		}
+
+		r := slog.NewRecord(time.Now(), level, record.Message, 0)
+		if record.Domain != "" {
+			r.AddAttrs(slog.String("domain", record.Domain))
+		}
+		keys := make([]string, 0, len(record.Fields))
+		for key := range record.Fields {
+			switch key {
+			case "GLIB_DOMAIN", "MESSAGE", "PRIORITY":
+			default:
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			r.AddAttrs(slog.String(strings.ToLower(key), record.Fields[key]))
+		}
+		if handler.Handle(ctx, r) != nil {
+			return LOG_WRITER_UNHANDLED
+		}
+		return LOG_WRITER_HANDLED
+	}
+}
@@ -0,0 +1,51 @@
+// +build !glib_2_40,!glib_2_42,!glib_2_44,!glib_2_46,!glib_2_48
+
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestSetLogWriter(t *testing.T) {
+	var records []*glib.LogRecord
+	glib.SetLogWriter(func(record *glib.LogRecord) glib.LogWriterOutput {
+		if record.Domain != "gotk3-test" {
+			return glib.LOG_WRITER_UNHANDLED
+		}
+		records = append(records, record)
+		return glib.LOG_WRITER_HANDLED
+	})
+	defer glib.SetLogWriter(nil)
+
+	glib.LogWarning("gotk3-test", "answer is %d", 42)
+	glib.LogStructured("gotk3-test", glib.LOG_LEVEL_MESSAGE, "structured",
+		map[string]string{"REQUEST_ID": "1234"})
+
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if records[0].Level&glib.LOG_LEVEL_WARNING == 0 || records[0].Message != "answer is 42" {
+		t.Errorf("Expected a warning %q, got %s %q", "answer is 42",
+			records[0].Level, records[0].Message)
+	}
+	if id := records[1].Fields["REQUEST_ID"]; id != "1234" {
+		t.Errorf("Expected field REQUEST_ID %q, got %q", "1234", id)
+	}
+}
+
+func TestLogSetHandler(t *testing.T) {
+	var messages []string
+	id := glib.LogSetHandler("gotk3-test", glib.LOG_LEVEL_MASK,
+		func(domain string, level glib.LogLevelFlags, message string) {
+			messages = append(messages, message)
+		})
+
+	glib.LogMessage("gotk3-test", "handled")
+	glib.LogRemoveHandler("gotk3-test", id)
+
+	if len(messages) != 1 || messages[0] != "handled" {
+		t.Errorf("Expected message %q, got %v", "handled", messages)
+	}
+}
@@ -64,7 +64,24 @@ func (v *MenuModel) GetNItems() int {
 	return int(C.g_menu_model_get_n_items(v.native()))
 }
 
-// GetItemLink is a wrapper around g_menu_model_get_item_link().
+// Standard attributes of menu items.
+const (
+	MENU_ATTRIBUTE_ACTION           = "action"
+	MENU_ATTRIBUTE_ACTION_NAMESPACE = "action-namespace"
+	MENU_ATTRIBUTE_TARGET           = "target"
+	MENU_ATTRIBUTE_LABEL            = "label"
+	MENU_ATTRIBUTE_ICON             = "icon"
+)
+
+// Standard links of menu items.
+const (
+	MENU_LINK_SECTION = "section"
+	MENU_LINK_SUBMENU = "submenu"
+)
+
+// GetItemLink is a wrapper around g_menu_model_get_item_link(). link is
+// one of the MENU_LINK constants, or nil is returned if the item has no
+// such link.
 func (v *MenuModel) GetItemLink(index int, link string) *MenuModel {
 	cstr := C.CString(link)
 	defer C.free(unsafe.Pointer(cstr))
@@ -72,7 +89,10 @@ func (v *MenuModel) GetItemLink(index int, link string) *MenuModel {
 	if c == nil {
 		return nil
 	}
-	return wrapMenuModel(wrapObject(unsafe.Pointer(c)))
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapMenuModel(obj)
 }
 
 // ItemsChanged is a wrapper around g_menu_model_items_changed().
@@ -80,10 +100,189 @@ func (v *MenuModel) ItemsChanged(position, removed, added int) {
 	C.g_menu_model_items_changed(v.native(), C.gint(position), C.gint(removed), C.gint(added))
 }
 
-// GVariant * 	g_menu_model_get_item_attribute_value ()
-// gboolean 	g_menu_model_get_item_attribute ()
-// GMenuAttributeIter * 	g_menu_model_iterate_item_attributes ()
-// GMenuLinkIter * 	g_menu_model_iterate_item_links ()
+// ConnectItemsChanged connects f to the "items-changed" signal of the
+// model: removed items at position were replaced by added ones. Links
+// of the items are separate models, which emit their own signal.
+func (v *MenuModel) ConnectItemsChanged(f func(position, removed, added int)) (SignalHandle, error) {
+	return v.Connect("items-changed", func(_ interface{}, position, removed, added int) {
+		f(position, removed, added)
+	})
+}
+
+// GetItemAttributeValue is a wrapper around
+// g_menu_model_get_item_attribute_value(). It returns nil if the item has
+// no such attribute, or if expectedType is not nil and the attribute is
+// of another type.
+func (v *MenuModel) GetItemAttributeValue(index int, attribute string,
+	expectedType *VariantType) *Variant {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_menu_model_get_item_attribute_value(v.native(), C.gint(index),
+		(*C.gchar)(cstr), expectedType.native())
+	return takeVariant(c)
+}
+
+// GetItemAttributeString returns the value of a string attribute of an
+// item, like MENU_ATTRIBUTE_LABEL, and whether the item has it. It is
+// based on g_menu_model_get_item_attribute_value().
+func (v *MenuModel) GetItemAttributeString(index int, attribute string) (string, bool) {
+	cstr := C.CString(attribute)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_menu_model_get_item_attribute_value(v.native(), C.gint(index),
+		(*C.gchar)(cstr), VARIANT_TYPE_STRING.native())
+	if c == nil {
+		return "", false
+	}
+	defer C.g_variant_unref(c)
+	// The string belongs to the variant.
+	return goString(C.g_variant_get_string(c, nil)), true
+}
+
+// IterateItemAttributes is a wrapper around
+// g_menu_model_iterate_item_attributes().
+func (v *MenuModel) IterateItemAttributes(index int) *MenuAttributeIter {
+	c := C.g_menu_model_iterate_item_attributes(v.native(), C.gint(index))
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapMenuAttributeIter(obj)
+}
+
+// IterateItemLinks is a wrapper around g_menu_model_iterate_item_links().
+func (v *MenuModel) IterateItemLinks(index int) *MenuLinkIter {
+	c := C.g_menu_model_iterate_item_links(v.native(), C.gint(index))
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapMenuLinkIter(obj)
+}
+
+// MenuAttributeIter is a representation of GMenuAttributeIter, iterating
+// over the attributes of an item of a MenuModel:
+//
+//	iter := model.IterateItemAttributes(index)
+//	for iter.Next() {
+//		name, value := iter.GetName(), iter.GetValue()
+//		...
+//	}
+type MenuAttributeIter struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GMenuAttributeIter.
+func (v *MenuAttributeIter) native() *C.GMenuAttributeIter {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGMenuAttributeIter(ptr)
+}
+
+func marshalMenuAttributeIter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapMenuAttributeIter(wrapObject(unsafe.Pointer(c))), nil
+}
+
+func wrapMenuAttributeIter(obj *Object) *MenuAttributeIter {
+	return &MenuAttributeIter{obj}
+}
+
+// Next is a wrapper around g_menu_attribute_iter_next(). It moves to the
+// next attribute, and returns false once there is none left.
+func (v *MenuAttributeIter) Next() bool {
+	return gobool(C.g_menu_attribute_iter_next(v.native()))
+}
+
+// GetName is a wrapper around g_menu_attribute_iter_get_name().
+func (v *MenuAttributeIter) GetName() string {
+	return goString(C.g_menu_attribute_iter_get_name(v.native()))
+}
+
+// GetValue is a wrapper around g_menu_attribute_iter_get_value().
+func (v *MenuAttributeIter) GetValue() *Variant {
+	return takeVariant(C.g_menu_attribute_iter_get_value(v.native()))
+}
+
+// GetNext is a wrapper around g_menu_attribute_iter_get_next(). It moves
+// to the next attribute and returns it, or false once there is none
+// left.
+func (v *MenuAttributeIter) GetNext() (name string, value *Variant, ok bool) {
+	var cname *C.gchar
+	var cvalue *C.GVariant
+	if !gobool(C.g_menu_attribute_iter_get_next(v.native(), &cname, &cvalue)) {
+		return "", nil, false
+	}
+	return goString(cname), takeVariant(cvalue), true
+}
+
+// MenuLinkIter is a representation of GMenuLinkIter, iterating over the
+// links of an item of a MenuModel, such as its section or submenu.
+type MenuLinkIter struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GMenuLinkIter.
+func (v *MenuLinkIter) native() *C.GMenuLinkIter {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGMenuLinkIter(ptr)
+}
+
+func marshalMenuLinkIter(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	return wrapMenuLinkIter(wrapObject(unsafe.Pointer(c))), nil
+}
+
+func wrapMenuLinkIter(obj *Object) *MenuLinkIter {
+	return &MenuLinkIter{obj}
+}
+
+// Next is a wrapper around g_menu_link_iter_next(). It moves to the next
+// link, and returns false once there is none left.
+func (v *MenuLinkIter) Next() bool {
+	return gobool(C.g_menu_link_iter_next(v.native()))
+}
+
+// GetName is a wrapper around g_menu_link_iter_get_name().
+func (v *MenuLinkIter) GetName() string {
+	return goString(C.g_menu_link_iter_get_name(v.native()))
+}
+
+// GetValue is a wrapper around g_menu_link_iter_get_value().
+func (v *MenuLinkIter) GetValue() *MenuModel {
+	c := C.g_menu_link_iter_get_value(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapMenuModel(obj)
+}
+
+// GetNext is a wrapper around g_menu_link_iter_get_next(). It moves to
+// the next link and returns it, or false once there is none left.
+func (v *MenuLinkIter) GetNext() (name string, value *MenuModel, ok bool) {
+	var cname *C.gchar
+	var cvalue *C.GMenuModel
+	if !gobool(C.g_menu_link_iter_get_next(v.native(), &cname, &cvalue)) {
+		return "", nil, false
+	}
+	obj := wrapObject(unsafe.Pointer(cvalue))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(cvalue))
+	return goString(cname), wrapMenuModel(obj), true
+}
 
 // Menu is a representation of GMenu.
 type Menu struct {
@@ -431,6 +630,8 @@ func init() {
 		{Type(C.g_menu_model_get_type()), marshalMenuModel},
 		{Type(C.g_menu_get_type()), marshalMenu},
 		{Type(C.g_menu_item_get_type()), marshalMenuItem},
+		{Type(C.g_menu_attribute_iter_get_type()), marshalMenuAttributeIter},
+		{Type(C.g_menu_link_iter_get_type()), marshalMenuLinkIter},
 	}
 	RegisterGValueMarshalers(tm)
 }
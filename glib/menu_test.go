@@ -0,0 +1,68 @@
+package glib_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestMenuModelTraversal(t *testing.T) {
+	section, err := glib.MenuNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	section.Append("Copy", "app.copy")
+
+	menu, err := glib.MenuNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var changes [][3]int
+	menu.ConnectItemsChanged(func(position, removed, added int) {
+		changes = append(changes, [3]int{position, removed, added})
+	})
+	menu.AppendSection("Edit", section)
+	menu.Append("Quit", "app.quit")
+
+	if len(changes) != 2 || changes[1] != [3]int{1, 0, 1} {
+		t.Errorf("Expected two single insertions, got %v", changes)
+	}
+	if n := menu.GetNItems(); n != 2 {
+		t.Fatalf("Expected 2 items, got %d", n)
+	}
+
+	var names []string
+	iter := menu.IterateItemAttributes(1)
+	for iter.Next() {
+		names = append(names, iter.GetName())
+		if iter.GetValue() == nil {
+			t.Errorf("Expected a value for attribute %q", iter.GetName())
+		}
+	}
+	sort.Strings(names)
+	if len(names) != 2 || names[0] != glib.MENU_ATTRIBUTE_ACTION || names[1] != glib.MENU_ATTRIBUTE_LABEL {
+		t.Errorf("Expected the action and label attributes, got %v", names)
+	}
+	if label, ok := menu.GetItemAttributeString(1, glib.MENU_ATTRIBUTE_LABEL); !ok || label != "Quit" {
+		t.Errorf("Expected the label %q, got %q", "Quit", label)
+	}
+	if _, ok := menu.GetItemAttributeString(1, glib.MENU_ATTRIBUTE_ICON); ok {
+		t.Error("Expected no icon attribute")
+	}
+
+	links := menu.IterateItemLinks(0)
+	name, model, ok := links.GetNext()
+	if !ok || name != glib.MENU_LINK_SECTION {
+		t.Fatalf("Expected a section link, got %q", name)
+	}
+	if action, ok := model.GetItemAttributeString(0, glib.MENU_ATTRIBUTE_ACTION); !ok || action != "app.copy" {
+		t.Errorf("Expected the action %q, got %q", "app.copy", action)
+	}
+	if _, _, ok := links.GetNext(); ok {
+		t.Error("Expected a single link")
+	}
+	if menu.GetItemLink(1, glib.MENU_LINK_SUBMENU) != nil {
+		t.Error("Expected no submenu")
+	}
+}
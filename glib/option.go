@@ -0,0 +1,645 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "option.go.h"
+import "C"
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+// OptionArg is a representation of GLib's GOptionArg.
+type OptionArg int
+
+const (
+	OPTION_ARG_NONE           OptionArg = C.G_OPTION_ARG_NONE
+	OPTION_ARG_STRING         OptionArg = C.G_OPTION_ARG_STRING
+	OPTION_ARG_INT            OptionArg = C.G_OPTION_ARG_INT
+	OPTION_ARG_CALLBACK       OptionArg = C.G_OPTION_ARG_CALLBACK
+	OPTION_ARG_FILENAME       OptionArg = C.G_OPTION_ARG_FILENAME
+	OPTION_ARG_STRING_ARRAY   OptionArg = C.G_OPTION_ARG_STRING_ARRAY
+	OPTION_ARG_FILENAME_ARRAY OptionArg = C.G_OPTION_ARG_FILENAME_ARRAY
+	OPTION_ARG_DOUBLE         OptionArg = C.G_OPTION_ARG_DOUBLE
+	OPTION_ARG_INT64          OptionArg = C.G_OPTION_ARG_INT64
+)
+
+// OptionFlags is a representation of GLib's GOptionFlags.
+type OptionFlags int
+
+const (
+	OPTION_FLAG_NONE         OptionFlags = C.G_OPTION_FLAG_NONE
+	OPTION_FLAG_HIDDEN       OptionFlags = C.G_OPTION_FLAG_HIDDEN
+	OPTION_FLAG_IN_MAIN      OptionFlags = C.G_OPTION_FLAG_IN_MAIN
+	OPTION_FLAG_REVERSE      OptionFlags = C.G_OPTION_FLAG_REVERSE
+	OPTION_FLAG_NO_ARG       OptionFlags = C.G_OPTION_FLAG_NO_ARG
+	OPTION_FLAG_FILENAME     OptionFlags = C.G_OPTION_FLAG_FILENAME
+	OPTION_FLAG_OPTIONAL_ARG OptionFlags = C.G_OPTION_FLAG_OPTIONAL_ARG
+	OPTION_FLAG_NOALIAS      OptionFlags = C.G_OPTION_FLAG_NOALIAS
+)
+
+// OPTION_REMAINING is the long name of an entry collecting all
+// non-option arguments into a OPTION_ARG_STRING_ARRAY or
+// OPTION_ARG_FILENAME_ARRAY value.
+const OPTION_REMAINING string = ""
+
+// OptionArgFunc is the Go callback bound to OPTION_ARG_CALLBACK entries.
+// name is the option as it appeared on the command line ("--name" or "-n"),
+// value is empty when the option takes no argument. A non-nil error
+// aborts parsing with G_OPTION_ERROR_FAILED.
+type OptionArgFunc func(name, value string) error
+
+// OptionEntry is a representation of GLib's GOptionEntry.
+//
+// Value must point to the Go variable which receives the parsed value:
+// *bool for OPTION_ARG_NONE, *int for OPTION_ARG_INT, *int64 for
+// OPTION_ARG_INT64, *float64 for OPTION_ARG_DOUBLE, *string for
+// OPTION_ARG_STRING and OPTION_ARG_FILENAME, *[]string for
+// OPTION_ARG_STRING_ARRAY and OPTION_ARG_FILENAME_ARRAY and an
+// OptionArgFunc for OPTION_ARG_CALLBACK. The current content of the
+// variable is kept when the option is not given on the command line.
+type OptionEntry struct {
+	LongName       string
+	ShortName      byte
+	Flags          OptionFlags
+	Arg            OptionArg
+	Value          interface{}
+	Description    string
+	ArgDescription string
+}
+
+// optionBinding keeps the C storage GLib writes an option value to,
+// along with the Go variable it is copied to after parsing.
+type optionBinding struct {
+	arg   OptionArg
+	value interface{}
+	data  C.gpointer
+}
+
+// optionGroupData is everything that must outlive the GOptionEntry
+// arrays registered with a GOptionGroup: GLib keeps pointers to the
+// strings and to the storage of every entry.
+type optionGroupData struct {
+	bindings  []*optionBinding
+	callbacks map[string]OptionArgFunc
+	cstrs     []*C.gchar
+}
+
+var (
+	optionGroupRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]*optionGroupData
+	}{
+		next: 1,
+		m:    make(map[int]*optionGroupData),
+	}
+)
+
+func lookupOptionGroupData(data C.gpointer) *optionGroupData {
+	id := int(uintptr(data))
+
+	optionGroupRegistry.RLock()
+	d := optionGroupRegistry.m[id]
+	optionGroupRegistry.RUnlock()
+	return d
+}
+
+func setOptionError(err **C.GError, message string) {
+	cstr := C.CString(message)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_set_error_literal(err, C.g_option_error_quark(),
+		C.gint(C.G_OPTION_ERROR_FAILED), (*C.gchar)(cstr))
+}
+
+//export goOptionArgFunc
+func goOptionArgFunc(optionName *C.gchar, value *C.gchar,
+	data C.gpointer, err **C.GError) C.gboolean {
+
+	d := lookupOptionGroupData(data)
+	if d == nil {
+		return gbool(true)
+	}
+
+	name := goString(optionName)
+	fn, ok := d.callbacks[name]
+	if !ok {
+		return gbool(true)
+	}
+
+	var val string
+	if value != nil {
+		val = goString(value)
+	}
+	if e := fn(name, val); e != nil {
+		setOptionError(err, e.Error())
+		return gbool(false)
+	}
+	return gbool(true)
+}
+
+//export goOptionGroupPreParse
+func goOptionGroupPreParse(context *C.GOptionContext, group *C.GOptionGroup,
+	data C.gpointer, err **C.GError) C.gboolean {
+
+	if d := lookupOptionGroupData(data); d != nil {
+		for _, b := range d.bindings {
+			b.load()
+		}
+	}
+	return gbool(true)
+}
+
+//export goOptionGroupPostParse
+func goOptionGroupPostParse(context *C.GOptionContext, group *C.GOptionGroup,
+	data C.gpointer, err **C.GError) C.gboolean {
+
+	if d := lookupOptionGroupData(data); d != nil {
+		for _, b := range d.bindings {
+			b.store()
+		}
+	}
+	return gbool(true)
+}
+
+//export goOptionGroupDestroy
+func goOptionGroupDestroy(data C.gpointer) {
+	id := int(uintptr(data))
+
+	optionGroupRegistry.Lock()
+	d := optionGroupRegistry.m[id]
+	delete(optionGroupRegistry.m, id)
+	optionGroupRegistry.Unlock()
+
+	if d == nil {
+		return
+	}
+	for _, b := range d.bindings {
+		b.free()
+	}
+	for _, cstr := range d.cstrs {
+		C.free(unsafe.Pointer(cstr))
+	}
+}
+
+func newOptionBinding(e *OptionEntry) (*optionBinding, error) {
+	var size C.gsize
+	var ok bool
+
+	switch e.Arg {
+	case OPTION_ARG_NONE:
+		_, ok = e.Value.(*bool)
+		size = C.gsize(C.sizeof_gboolean)
+	case OPTION_ARG_INT:
+		_, ok = e.Value.(*int)
+		size = C.gsize(C.sizeof_gint)
+	case OPTION_ARG_INT64:
+		_, ok = e.Value.(*int64)
+		size = C.gsize(C.sizeof_gint64)
+	case OPTION_ARG_DOUBLE:
+		_, ok = e.Value.(*float64)
+		size = C.gsize(C.sizeof_gdouble)
+	case OPTION_ARG_STRING, OPTION_ARG_FILENAME:
+		_, ok = e.Value.(*string)
+		size = C.gsize(unsafe.Sizeof(uintptr(0)))
+	case OPTION_ARG_STRING_ARRAY, OPTION_ARG_FILENAME_ARRAY:
+		_, ok = e.Value.(*[]string)
+		size = C.gsize(unsafe.Sizeof(uintptr(0)))
+	case OPTION_ARG_CALLBACK:
+		_, ok = e.Value.(OptionArgFunc)
+		if !ok {
+			_, ok = e.Value.(func(string, string) error)
+		}
+		if !ok {
+			return nil, fmt.Errorf("option %q: callback must be an OptionArgFunc", e.LongName)
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("option %q: unknown argument type %d", e.LongName, e.Arg)
+	}
+	if !ok {
+		return nil, fmt.Errorf("option %q: unsuitable value type %T", e.LongName, e.Value)
+	}
+
+	b := &optionBinding{arg: e.Arg, value: e.Value}
+	b.data = C.g_malloc0(size)
+	b.load()
+	return b, nil
+}
+
+// load copies the Go variable into the C storage GLib parses into.
+// Strings and arrays are left unset, so that GLib only fills them in
+// when the option is given.
+func (b *optionBinding) load() {
+	switch b.arg {
+	case OPTION_ARG_NONE:
+		*(*C.gboolean)(b.data) = gbool(*b.value.(*bool))
+	case OPTION_ARG_INT:
+		*(*C.gint)(b.data) = C.gint(*b.value.(*int))
+	case OPTION_ARG_INT64:
+		*(*C.gint64)(b.data) = C.gint64(*b.value.(*int64))
+	case OPTION_ARG_DOUBLE:
+		*(*C.gdouble)(b.data) = C.gdouble(*b.value.(*float64))
+	}
+}
+
+// store copies the value parsed by GLib back into the Go variable.
+func (b *optionBinding) store() {
+	switch b.arg {
+	case OPTION_ARG_NONE:
+		*b.value.(*bool) = gobool(*(*C.gboolean)(b.data))
+	case OPTION_ARG_INT:
+		*b.value.(*int) = int(*(*C.gint)(b.data))
+	case OPTION_ARG_INT64:
+		*b.value.(*int64) = int64(*(*C.gint64)(b.data))
+	case OPTION_ARG_DOUBLE:
+		*b.value.(*float64) = float64(*(*C.gdouble)(b.data))
+	case OPTION_ARG_STRING, OPTION_ARG_FILENAME:
+		p := (**C.gchar)(b.data)
+		if *p != nil {
+			*b.value.(*string) = goString(*p)
+			C.g_free(C.gpointer(*p))
+			*p = nil
+		}
+	case OPTION_ARG_STRING_ARRAY, OPTION_ARG_FILENAME_ARRAY:
+		p := (***C.gchar)(b.data)
+		if *p != nil {
+			*b.value.(*[]string) = goStringArray(*p)
+			C.g_strfreev(*p)
+			*p = nil
+		}
+	}
+}
+
+func (b *optionBinding) free() {
+	switch b.arg {
+	case OPTION_ARG_STRING, OPTION_ARG_FILENAME:
+		C.g_free(C.gpointer(*(**C.gchar)(b.data)))
+	case OPTION_ARG_STRING_ARRAY, OPTION_ARG_FILENAME_ARRAY:
+		C.g_strfreev(*(***C.gchar)(b.data))
+	}
+	C.g_free(b.data)
+}
+
+/*
+ * GOptionGroup
+ */
+
+// OptionGroup is a representation of GLib's GOptionGroup.
+//
+// A group added to an OptionContext with AddGroup or SetMainGroup is
+// owned by the context, and is freed along with it. Other groups are
+// freed once they are garbage collected.
+type OptionGroup struct {
+	group *C.GOptionGroup
+	// Registry key of the Go side data, 0 for groups
+	// not created by OptionGroupNew (like GTK's own group).
+	id int
+}
+
+// native returns a pointer to the underlying GOptionGroup.
+func (v *OptionGroup) native() *C.GOptionGroup {
+	if v == nil {
+		return nil
+	}
+	return v.group
+}
+
+// Native returns a pointer to the underlying GOptionGroup.
+func (v *OptionGroup) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// WrapOptionGroup wraps a GOptionGroup created outside of this package,
+// like the one returned by gtk_get_option_group(), taking ownership of
+// it. This function is exported for visibility in other gotk3 packages
+// and is not meant to be used by applications.
+func WrapOptionGroup(ptr uintptr) *OptionGroup {
+	if ptr == 0 {
+		return nil
+	}
+	v := &OptionGroup{group: (*C.GOptionGroup)(unsafe.Pointer(ptr))}
+	runtime.SetFinalizer(v, (*OptionGroup).free)
+	return v
+}
+
+// free is a wrapper around g_option_group_unref(), or
+// g_option_group_free() before GLib 2.44. The destroy notify of
+// groups created by OptionGroupNew releases their Go side data.
+func (v *OptionGroup) free() {
+	C._g_option_group_free(v.native())
+}
+
+// OptionGroupNew is a wrapper around g_option_group_new(). name is used
+// for the --help-name option, description is the header of the group in
+// --help output and helpDescription describes --help-name itself.
+func OptionGroupNew(name, description, helpDescription string) (*OptionGroup, error) {
+	cstr1 := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(description)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	cstr3 := C.CString(helpDescription)
+	defer C.free(unsafe.Pointer(cstr3))
+
+	optionGroupRegistry.Lock()
+	id := optionGroupRegistry.next
+	optionGroupRegistry.next++
+	optionGroupRegistry.m[id] = &optionGroupData{
+		callbacks: make(map[string]OptionArgFunc),
+	}
+	optionGroupRegistry.Unlock()
+
+	c := C._g_option_group_new((*C.gchar)(cstr1), (*C.gchar)(cstr2),
+		(*C.gchar)(cstr3), C.gpointer(uintptr(id)))
+	if c == nil {
+		goOptionGroupDestroy(C.gpointer(uintptr(id)))
+		return nil, errNilPtr
+	}
+
+	v := &OptionGroup{group: c, id: id}
+	runtime.SetFinalizer(v, (*OptionGroup).free)
+	return v, nil
+}
+
+// AddEntries is a wrapper around g_option_group_add_entries(). Each
+// entry is bound to the Go variable (or callback) set in its Value.
+func (v *OptionGroup) AddEntries(entries []OptionEntry) error {
+	optionGroupRegistry.RLock()
+	d := optionGroupRegistry.m[v.id]
+	optionGroupRegistry.RUnlock()
+	if d == nil {
+		return errors.New("option group was not created by OptionGroupNew")
+	}
+
+	bindings := make([]*optionBinding, len(entries))
+	for i := range entries {
+		b, err := newOptionBinding(&entries[i])
+		if err != nil {
+			for _, b := range bindings[:i] {
+				if b != nil {
+					b.free()
+				}
+			}
+			return err
+		}
+		bindings[i] = b
+	}
+
+	centries := C._g_option_entries_new(C.int(len(entries)))
+	// g_option_group_add_entries() copies the array itself,
+	// but keeps referencing the strings and the storage.
+	defer C.g_free(C.gpointer(centries))
+
+	for i, e := range entries {
+		var data C.gpointer
+		if b := bindings[i]; b != nil {
+			d.bindings = append(d.bindings, b)
+			data = b.data
+		} else {
+			fn, ok := e.Value.(OptionArgFunc)
+			if !ok {
+				fn = OptionArgFunc(e.Value.(func(string, string) error))
+			}
+			if e.LongName != "" {
+				d.callbacks["--"+e.LongName] = fn
+			}
+			if e.ShortName != 0 {
+				d.callbacks["-"+string(e.ShortName)] = fn
+			}
+		}
+
+		cstr1 := C.CString(e.LongName)
+		var cstr2, cstr3 *C.char
+		if e.Description != "" {
+			cstr2 = C.CString(e.Description)
+			d.cstrs = append(d.cstrs, (*C.gchar)(cstr2))
+		}
+		if e.ArgDescription != "" {
+			cstr3 = C.CString(e.ArgDescription)
+			d.cstrs = append(d.cstrs, (*C.gchar)(cstr3))
+		}
+		d.cstrs = append(d.cstrs, (*C.gchar)(cstr1))
+
+		C._g_option_entries_set(centries, C.int(i), (*C.gchar)(cstr1),
+			C.gchar(e.ShortName), C.gint(e.Flags), C.GOptionArg(e.Arg),
+			data, (*C.gchar)(cstr2), (*C.gchar)(cstr3))
+	}
+
+	C.g_option_group_add_entries(v.native(), centries)
+	return nil
+}
+
+// SetTranslationDomain is a wrapper around g_option_group_set_translation_domain().
+func (v *OptionGroup) SetTranslationDomain(domain string) {
+	cstr := C.CString(domain)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_option_group_set_translation_domain(v.native(), (*C.gchar)(cstr))
+}
+
+/*
+ * GOptionContext
+ */
+
+// OptionContext is a representation of GLib's GOptionContext.
+type OptionContext struct {
+	context *C.GOptionContext
+	// Main group set with SetMainGroup or AddMainEntries.
+	mainGroup *OptionGroup
+}
+
+// native returns a pointer to the underlying GOptionContext.
+func (v *OptionContext) native() *C.GOptionContext {
+	if v == nil {
+		return nil
+	}
+	return v.context
+}
+
+// Native returns a pointer to the underlying GOptionContext.
+func (v *OptionContext) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// OptionContextNew is a wrapper around g_option_context_new().
+// parameterString is printed after the program name in the first
+// line of --help output, like "FILE… - edit files".
+func OptionContextNew(parameterString string) (*OptionContext, error) {
+	var cstr *C.char
+	if parameterString != "" {
+		cstr = C.CString(parameterString)
+		defer C.free(unsafe.Pointer(cstr))
+	}
+
+	c := C.g_option_context_new((*C.gchar)(cstr))
+	if c == nil {
+		return nil, errNilPtr
+	}
+
+	v := &OptionContext{context: c}
+	runtime.SetFinalizer(v, (*OptionContext).free)
+	return v, nil
+}
+
+// free is a wrapper around g_option_context_free().
+func (v *OptionContext) free() {
+	C.g_option_context_free(v.native())
+}
+
+// SetSummary is a wrapper around g_option_context_set_summary().
+func (v *OptionContext) SetSummary(summary string) {
+	cstr := C.CString(summary)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_option_context_set_summary(v.native(), (*C.gchar)(cstr))
+}
+
+// GetSummary is a wrapper around g_option_context_get_summary().
+func (v *OptionContext) GetSummary() string {
+	c := C.g_option_context_get_summary(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// SetDescription is a wrapper around g_option_context_set_description().
+func (v *OptionContext) SetDescription(description string) {
+	cstr := C.CString(description)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_option_context_set_description(v.native(), (*C.gchar)(cstr))
+}
+
+// GetDescription is a wrapper around g_option_context_get_description().
+func (v *OptionContext) GetDescription() string {
+	c := C.g_option_context_get_description(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// SetTranslationDomain is a wrapper around g_option_context_set_translation_domain().
+func (v *OptionContext) SetTranslationDomain(domain string) {
+	cstr := C.CString(domain)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_option_context_set_translation_domain(v.native(), (*C.gchar)(cstr))
+}
+
+// SetHelpEnabled is a wrapper around g_option_context_set_help_enabled().
+func (v *OptionContext) SetHelpEnabled(helpEnabled bool) {
+	C.g_option_context_set_help_enabled(v.native(), gbool(helpEnabled))
+}
+
+// GetHelpEnabled is a wrapper around g_option_context_get_help_enabled().
+func (v *OptionContext) GetHelpEnabled() bool {
+	return gobool(C.g_option_context_get_help_enabled(v.native()))
+}
+
+// SetIgnoreUnknownOptions is a wrapper around g_option_context_set_ignore_unknown_options().
+func (v *OptionContext) SetIgnoreUnknownOptions(ignoreUnknown bool) {
+	C.g_option_context_set_ignore_unknown_options(v.native(), gbool(ignoreUnknown))
+}
+
+// GetIgnoreUnknownOptions is a wrapper around g_option_context_get_ignore_unknown_options().
+func (v *OptionContext) GetIgnoreUnknownOptions() bool {
+	return gobool(C.g_option_context_get_ignore_unknown_options(v.native()))
+}
+
+// GetHelp is a wrapper around g_option_context_get_help(). If group
+// is nil and mainHelp is true, the help of the main group is returned.
+func (v *OptionContext) GetHelp(mainHelp bool, group *OptionGroup) string {
+	c := C.g_option_context_get_help(v.native(), gbool(mainHelp), group.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// AddGroup is a wrapper around g_option_context_add_group().
+// The context takes ownership of group.
+func (v *OptionContext) AddGroup(group *OptionGroup) {
+	C.g_option_context_add_group(v.native(), group.native())
+	runtime.SetFinalizer(group, nil)
+}
+
+// SetMainGroup is a wrapper around g_option_context_set_main_group().
+// The context takes ownership of group.
+func (v *OptionContext) SetMainGroup(group *OptionGroup) {
+	C.g_option_context_set_main_group(v.native(), group.native())
+	runtime.SetFinalizer(group, nil)
+	v.mainGroup = group
+}
+
+// GetMainGroup is a wrapper around g_option_context_get_main_group().
+func (v *OptionContext) GetMainGroup() *OptionGroup {
+	if v.mainGroup != nil {
+		return v.mainGroup
+	}
+	c := C.g_option_context_get_main_group(v.native())
+	if c == nil {
+		return nil
+	}
+	// The group belongs to the context.
+	return &OptionGroup{group: c}
+}
+
+// AddMainEntries adds entries to the main group of the context, creating
+// it when necessary. It replaces g_option_context_add_main_entries(),
+// which does not allow to bind entries to Go variables.
+func (v *OptionContext) AddMainEntries(entries []OptionEntry, translationDomain string) error {
+	if v.mainGroup != nil {
+		return v.mainGroup.AddEntries(entries)
+	}
+	if C.g_option_context_get_main_group(v.native()) != nil {
+		return errors.New("main group was not created by OptionGroupNew")
+	}
+
+	group, err := OptionGroupNew("", "", "")
+	if err != nil {
+		return err
+	}
+	if err := group.AddEntries(entries); err != nil {
+		runtime.SetFinalizer(group, nil)
+		group.free()
+		return err
+	}
+	if translationDomain != "" {
+		group.SetTranslationDomain(translationDomain)
+	}
+	v.SetMainGroup(group)
+	return nil
+}
+
+// Parse is a wrapper around g_option_context_parse_strv(). args must
+// include the program name as the first element, like os.Args does.
+// The arguments left after parsing are returned, starting with the
+// program name.
+func (v *OptionContext) Parse(args []string) ([]string, error) {
+	argv := C._g_strv_new(C.int(len(args)))
+	for i, arg := range args {
+		cstr := C.CString(arg)
+		C._g_strv_set_dup(argv, C.int(i), (*C.gchar)(cstr))
+		C.free(unsafe.Pointer(cstr))
+	}
+
+	var err *C.GError
+	c := C.g_option_context_parse_strv(v.native(), &argv, &err)
+	// Arguments handled by GLib are already freed and
+	// removed from argv, the rest belongs to us.
+	defer C.g_strfreev(argv)
+	if c == 0 {
+		return nil, takeError(err)
+	}
+	return goStringArray(argv), nil
+}
@@ -0,0 +1,84 @@
+// Same copyright and license as the rest of the files in this project
+
+// GOptionContext, GOptionGroup, GOptionEntry
+// See: https://developer.gnome.org/glib/stable/glib-Commandline-option-parser.html
+
+#ifndef __GOPTION_GO_H__
+#define __GOPTION_GO_H__
+
+#include <glib.h>
+
+extern gboolean goOptionArgFunc(gchar *option_name, gchar *value,
+                                gpointer data, GError **error);
+extern gboolean goOptionGroupPreParse(GOptionContext *context,
+                                      GOptionGroup *group,
+                                      gpointer data, GError **error);
+extern gboolean goOptionGroupPostParse(GOptionContext *context,
+                                       GOptionGroup *group,
+                                       gpointer data, GError **error);
+extern void goOptionGroupDestroy(gpointer data);
+
+static GOptionGroup *
+_g_option_group_new(const gchar *name, const gchar *description,
+                    const gchar *help_description, gpointer id)
+{
+	GOptionGroup *group;
+
+	group = g_option_group_new(name, description, help_description, id,
+		(GDestroyNotify)goOptionGroupDestroy);
+	g_option_group_set_parse_hooks(group,
+		(GOptionParseFunc)goOptionGroupPreParse,
+		(GOptionParseFunc)goOptionGroupPostParse);
+	return (group);
+}
+
+static void
+_g_option_group_free(GOptionGroup *group)
+{
+#if GLIB_CHECK_VERSION(2, 44, 0)
+	g_option_group_unref(group);
+#else
+	g_option_group_free(group);
+#endif
+}
+
+static GOptionEntry *
+_g_option_entries_new(int n)
+{
+	/* Extra zeroed element terminates the array. */
+	return (g_new0(GOptionEntry, n + 1));
+}
+
+static void
+_g_option_entries_set(GOptionEntry *entries, int i, const gchar *long_name,
+                      gchar short_name, gint flags, GOptionArg arg,
+                      gpointer arg_data, const gchar *description,
+                      const gchar *arg_description)
+{
+	GOptionEntry *e = &entries[i];
+
+	e->long_name = long_name;
+	e->short_name = short_name;
+	e->flags = flags;
+	e->arg = arg;
+	if (arg == G_OPTION_ARG_CALLBACK)
+		e->arg_data = (gpointer)goOptionArgFunc;
+	else
+		e->arg_data = arg_data;
+	e->description = description;
+	e->arg_description = arg_description;
+}
+
+static gchar **
+_g_strv_new(int n)
+{
+	return (g_new0(gchar *, n + 1));
+}
+
+static void
+_g_strv_set_dup(gchar **strv, int i, const gchar *str)
+{
+	strv[i] = g_strdup(str);
+}
+
+#endif
@@ -0,0 +1,20 @@
+// +build !glib_2_40,!glib_2_42
+
+// See: https://developer.gnome.org/glib/2.44/api-index-2-44.html
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <glib.h>
+// #include "glib.go.h"
+import "C"
+
+// SetStrictPosix is a wrapper around g_option_context_set_strict_posix().
+func (v *OptionContext) SetStrictPosix(strictPosix bool) {
+	C.g_option_context_set_strict_posix(v.native(), gbool(strictPosix))
+}
+
+// GetStrictPosix is a wrapper around g_option_context_get_strict_posix().
+func (v *OptionContext) GetStrictPosix() bool {
+	return gobool(C.g_option_context_get_strict_posix(v.native()))
+}
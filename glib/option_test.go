@@ -0,0 +1,116 @@
+package glib_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestOptionContext_Parse(t *testing.T) {
+	verbose := false
+	count := 1
+	name := "default"
+	var includes []string
+	var seen []string
+
+	context, err := glib.OptionContextNew("FILE…")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = context.AddMainEntries([]glib.OptionEntry{
+		{LongName: "verbose", ShortName: 'v', Arg: glib.OPTION_ARG_NONE, Value: &verbose},
+		{LongName: "count", Arg: glib.OPTION_ARG_INT, Value: &count},
+		{LongName: "name", Arg: glib.OPTION_ARG_STRING, Value: &name},
+		{LongName: "include", ShortName: 'I', Arg: glib.OPTION_ARG_FILENAME_ARRAY, Value: &includes},
+		{LongName: "trace", Arg: glib.OPTION_ARG_CALLBACK,
+			Flags: glib.OPTION_FLAG_NO_ARG,
+			Value: func(name, value string) error {
+				seen = append(seen, name)
+				return nil
+			}},
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rest, err := context.Parse([]string{"prog", "-v", "--count=3", "-I", "a", "--include", "b", "--trace", "file.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rest, []string{"prog", "file.txt"}) {
+		t.Errorf("remaining arguments: got %v", rest)
+	}
+	if !verbose || count != 3 {
+		t.Errorf("got verbose=%v count=%d", verbose, count)
+	}
+	if name != "default" {
+		t.Errorf("unset string option must keep its value, got %q", name)
+	}
+	if !reflect.DeepEqual(includes, []string{"a", "b"}) {
+		t.Errorf("include: got %v", includes)
+	}
+	if !reflect.DeepEqual(seen, []string{"--trace"}) {
+		t.Errorf("callback: got %v", seen)
+	}
+}
+
+func TestOptionContext_ParseError(t *testing.T) {
+	context, err := glib.OptionContextNew("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := glib.OptionGroupNew("extra", "Extra options", "Show extra options")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = group.AddEntries([]glib.OptionEntry{
+		{LongName: "fail", Arg: glib.OPTION_ARG_CALLBACK,
+			Value: glib.OptionArgFunc(func(name, value string) error {
+				return errors.New("bad " + value)
+			})},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	context.AddGroup(group)
+
+	if _, err := context.Parse([]string{"prog", "--fail=value"}); err == nil {
+		t.Error("callback error must abort parsing")
+	}
+	if _, err := context.Parse([]string{"prog", "--unknown"}); err == nil {
+		t.Error("unknown option must be reported")
+	}
+}
+
+func TestOptionContext_AddMainEntriesError(t *testing.T) {
+	context, err := glib.OptionContextNew("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	err = context.AddMainEntries([]glib.OptionEntry{
+		{LongName: "count", Arg: glib.OPTION_ARG_INT, Value: &count},
+		{LongName: "name", Arg: glib.OPTION_ARG_STRING, Value: &count},
+	}, "")
+	if err == nil {
+		t.Fatal("mismatched value type must be reported")
+	}
+	if context.GetMainGroup() != nil {
+		t.Error("failed entries must not leave a main group")
+	}
+
+	// The context is still usable once the failed group is freed.
+	err = context.AddMainEntries([]glib.OptionEntry{
+		{LongName: "count", Arg: glib.OPTION_ARG_INT, Value: &count},
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := context.Parse([]string{"prog", "--count=2"}); err != nil || count != 2 {
+		t.Errorf("got count=%d (%v)", count, err)
+	}
+}
@@ -0,0 +1,239 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"runtime"
+	"unsafe"
+)
+
+// ResourceFlags is a representation of GLib's GResourceFlags.
+type ResourceFlags int
+
+const (
+	RESOURCE_FLAGS_NONE       ResourceFlags = C.G_RESOURCE_FLAGS_NONE
+	RESOURCE_FLAGS_COMPRESSED ResourceFlags = C.G_RESOURCE_FLAGS_COMPRESSED
+)
+
+// ResourceLookupFlags is a representation of GLib's GResourceLookupFlags.
+type ResourceLookupFlags int
+
+const (
+	RESOURCE_LOOKUP_FLAGS_NONE ResourceLookupFlags = C.G_RESOURCE_LOOKUP_FLAGS_NONE
+)
+
+/*
+ * GResource
+ */
+
+// Resource is a representation of GLib's GResource.
+type Resource struct {
+	gresource *C.GResource
+}
+
+// Native returns a pointer to the underlying GResource.
+func (v *Resource) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+// native returns a pointer to the underlying GResource.
+func (v *Resource) native() *C.GResource {
+	if v == nil {
+		return nil
+	}
+	return v.gresource
+}
+
+func marshalResource(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	r := (*C.GResource)(unsafe.Pointer(c))
+	C.g_resource_ref(r)
+	return wrapResource(r), nil
+}
+
+// wrapResource wraps a full reference to a GResource, which is
+// released once the Resource is garbage collected.
+func wrapResource(r *C.GResource) *Resource {
+	v := &Resource{r}
+	runtime.SetFinalizer(v, func(v *Resource) {
+		C.g_resource_unref(v.gresource)
+	})
+	return v
+}
+
+// wrapBytesFull wraps a full reference to a GBytes, which is
+// released once the Bytes is garbage collected.
+func wrapBytesFull(c *C.GBytes) *Bytes {
+	bytes := newBytes(c)
+	runtime.SetFinalizer(bytes, (*Bytes).Unref)
+	return bytes
+}
+
+// ResourceLoad is a wrapper around g_resource_load().
+// filename is a resource bundle built by glib-compile-resources.
+func ResourceLoad(filename string) (*Resource, error) {
+	cstr := C.CString(filename)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resource_load((*C.gchar)(cstr), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return wrapResource(c), nil
+}
+
+// ResourceNewFromData is a wrapper around g_resource_new_from_data().
+func ResourceNewFromData(data *Bytes) (*Resource, error) {
+	var err *C.GError
+	c := C.g_resource_new_from_data(data.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return wrapResource(c), nil
+}
+
+// Register is a wrapper around g_resources_register().
+// Once registered, the files of the resource are found by every
+// function taking resource paths or "resource://" URIs.
+func (v *Resource) Register() {
+	C.g_resources_register(v.native())
+}
+
+// Unregister is a wrapper around g_resources_unregister().
+func (v *Resource) Unregister() {
+	C.g_resources_unregister(v.native())
+}
+
+// LookupData is a wrapper around g_resource_lookup_data().
+func (v *Resource) LookupData(path string, flags ResourceLookupFlags) (*Bytes, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resource_lookup_data(v.native(), cstr, C.GResourceLookupFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return wrapBytesFull(c), nil
+}
+
+// OpenStream is a wrapper around g_resource_open_stream().
+func (v *Resource) OpenStream(path string, flags ResourceLookupFlags) (*InputStream, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resource_open_stream(v.native(), cstr, C.GResourceLookupFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapInputStream(obj), nil
+}
+
+// EnumerateChildren is a wrapper around g_resource_enumerate_children().
+// The names of child directories end with a slash.
+func (v *Resource) EnumerateChildren(path string, flags ResourceLookupFlags) ([]string, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resource_enumerate_children(v.native(), cstr, C.GResourceLookupFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_strfreev((**C.gchar)(unsafe.Pointer(c)))
+
+	return goStringArray((**C.gchar)(unsafe.Pointer(c))), nil
+}
+
+// GetInfo is a wrapper around g_resource_get_info().
+func (v *Resource) GetInfo(path string, flags ResourceLookupFlags) (uint, ResourceFlags, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var size C.gsize
+	var rflags C.guint32
+	var err *C.GError
+	c := C.g_resource_get_info(v.native(), cstr, C.GResourceLookupFlags(flags),
+		&size, &rflags, &err)
+	if c == 0 {
+		return 0, 0, takeError(err)
+	}
+	return uint(size), ResourceFlags(rflags), nil
+}
+
+/*
+ * Global resources
+ */
+
+// ResourcesLookupData is a wrapper around g_resources_lookup_data().
+func ResourcesLookupData(path string, flags ResourceLookupFlags) (*Bytes, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resources_lookup_data(cstr, C.GResourceLookupFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return wrapBytesFull(c), nil
+}
+
+// ResourcesOpenStream is a wrapper around g_resources_open_stream().
+func ResourcesOpenStream(path string, flags ResourceLookupFlags) (*InputStream, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resources_open_stream(cstr, C.GResourceLookupFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapInputStream(obj), nil
+}
+
+// ResourcesEnumerateChildren is a wrapper around g_resources_enumerate_children().
+func ResourcesEnumerateChildren(path string, flags ResourceLookupFlags) ([]string, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_resources_enumerate_children(cstr, C.GResourceLookupFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	defer C.g_strfreev((**C.gchar)(unsafe.Pointer(c)))
+
+	return goStringArray((**C.gchar)(unsafe.Pointer(c))), nil
+}
+
+// ResourcesGetInfo is a wrapper around g_resources_get_info().
+func ResourcesGetInfo(path string, flags ResourceLookupFlags) (uint, ResourceFlags, error) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var size C.gsize
+	var rflags C.guint32
+	var err *C.GError
+	c := C.g_resources_get_info(cstr, C.GResourceLookupFlags(flags), &size, &rflags, &err)
+	if c == 0 {
+		return 0, 0, takeError(err)
+	}
+	return uint(size), ResourceFlags(rflags), nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_resource_get_type()), marshalResource},
+	}
+	RegisterGValueMarshalers(tm)
+}
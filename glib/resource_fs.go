@@ -0,0 +1,72 @@
+// +build go1.16
+
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"io/fs"
+	"path"
+	"strings"
+	"unsafe"
+)
+
+/*
+ * Resources from Go file systems
+ */
+
+// ResourceNewFromFS builds a resource holding every regular file of
+// fsys, such as an embed.FS, without the need of glib-compile-resources.
+// Files are found under prefix, so with the prefix "/org/example/app",
+// the file "ui/main.ui" of fsys has the resource path
+// "/org/example/app/ui/main.ui".
+func ResourceNewFromFS(fsys fs.FS, prefix string) (*Resource, error) {
+	prefix = "/" + strings.Trim(prefix, "/")
+
+	files := make(map[string][]byte)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		files[path.Join(prefix, name)] = data
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := gvdbResourceBundle(files)
+
+	// g_bytes_new() copies the bundle into memory suitably
+	// aligned for g_resource_new_from_data().
+	c := C.g_bytes_new(C.gconstpointer(unsafe.Pointer(&bundle[0])), C.gsize(len(bundle)))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	return ResourceNewFromData(wrapBytesFull(c))
+}
+
+// RegisterFS builds a resource from fsys with ResourceNewFromFS and
+// registers it, so the files of fsys may be loaded from the
+// "resource://" URIs and resource paths found under prefix.
+func RegisterFS(fsys fs.FS, prefix string) (*Resource, error) {
+	resource, err := ResourceNewFromFS(fsys, prefix)
+	if err != nil {
+		return nil, err
+	}
+	resource.Register()
+	return resource, nil
+}
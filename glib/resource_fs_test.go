@@ -0,0 +1,66 @@
+// +build go1.16
+
+package glib_test
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"testing/fstest"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestResourceNewFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"main.ui":          {Data: []byte("<interface/>")},
+		"css/style.css":    {Data: []byte("label { color: red; }")},
+		"icons/empty.svg":  {Data: []byte{}},
+		"icons/scalable/a": {Data: []byte("a")},
+	}
+
+	resource, err := glib.ResourceNewFromFS(fsys, "/org/gotk3/test/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := resource.LookupData("/org/gotk3/test/css/style.css", glib.RESOURCE_LOOKUP_FLAGS_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data.GetData()); got != "label { color: red; }" {
+		t.Errorf("LookupData: got %q", got)
+	}
+
+	size, _, err := resource.GetInfo("/org/gotk3/test/main.ui", glib.RESOURCE_LOOKUP_FLAGS_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != uint(len("<interface/>")) {
+		t.Errorf("GetInfo: got size %d", size)
+	}
+
+	children, err := resource.EnumerateChildren("/org/gotk3/test/", glib.RESOURCE_LOOKUP_FLAGS_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(children)
+	if !reflect.DeepEqual(children, []string{"css/", "icons/", "main.ui"}) {
+		t.Errorf("EnumerateChildren: got %v", children)
+	}
+
+	if _, err := resource.LookupData("/org/gotk3/test/missing", glib.RESOURCE_LOOKUP_FLAGS_NONE); err == nil {
+		t.Error("missing file must be reported")
+	}
+
+	resource.Register()
+	defer resource.Unregister()
+
+	data, err = glib.ResourcesLookupData("/org/gotk3/test/icons/scalable/a", glib.RESOURCE_LOOKUP_FLAGS_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(data.GetData()); got != "a" {
+		t.Errorf("ResourcesLookupData: got %q", got)
+	}
+}
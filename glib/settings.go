@@ -5,8 +5,11 @@ package glib
 // #include <glib.h>
 // #include <glib-object.h>
 // #include "glib.go.h"
+// #include "settings.go.h"
 import "C"
 import (
+	"runtime"
+	"sync"
 	"unsafe"
 )
 
@@ -322,6 +325,122 @@ func (v *Settings) Bind(key string, object IObject, property string, flags Setti
 		(*C.gchar)(cproperty), C.GSettingsBindFlags(flags))
 }
 
+// SettingsBindGetMapping converts the value of a key to the value of the
+// property it is bound to, which is converted to the type of the property
+// as by Value transformations. ok is false if value cannot be mapped.
+type SettingsBindGetMapping func(value *Variant) (property interface{}, ok bool)
+
+// SettingsBindSetMapping converts the value of a bound property to a value
+// of the key, of type expectedType. nil is returned if property cannot be
+// mapped.
+type SettingsBindSetMapping func(property interface{}, expectedType *VariantType) *Variant
+
+type settingsBindMapping struct {
+	get SettingsBindGetMapping
+	set SettingsBindSetMapping
+}
+
+var (
+	settingsBindMappingRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]settingsBindMapping
+	}{
+		next: 1,
+		m:    make(map[int]settingsBindMapping),
+	}
+)
+
+// BindWithMapping is a wrapper around g_settings_bind_with_mapping().
+// get and set convert values between the key and the property, for
+// instance between the nick of an enum and the active index of a combo
+// box. Either of them may be nil to use the default mapping.
+func (v *Settings) BindWithMapping(key string, object IObject, property string,
+	flags SettingsBindFlags, get SettingsBindGetMapping, set SettingsBindSetMapping) {
+	ckey := C.CString(key)
+	defer C.free(unsafe.Pointer(ckey))
+	cproperty := C.CString(property)
+	defer C.free(unsafe.Pointer(cproperty))
+
+	settingsBindMappingRegistry.Lock()
+	id := settingsBindMappingRegistry.next
+	settingsBindMappingRegistry.next++
+	settingsBindMappingRegistry.m[id] = settingsBindMapping{get, set}
+	settingsBindMappingRegistry.Unlock()
+
+	var cget C.GSettingsBindGetMapping
+	if get != nil {
+		cget = C._go_settings_bind_get_mapping()
+	}
+	var cset C.GSettingsBindSetMapping
+	if set != nil {
+		cset = C._go_settings_bind_set_mapping()
+	}
+
+	C.g_settings_bind_with_mapping(v.native(), (*C.gchar)(ckey),
+		C.gpointer(unsafe.Pointer(object.toObject().native())),
+		(*C.gchar)(cproperty), C.GSettingsBindFlags(flags), cget, cset,
+		C.gpointer(uintptr(id)), C.GDestroyNotify(C.goSettingsBindMappingDestroy))
+}
+
+//export goSettingsBindGetMapping
+func goSettingsBindGetMapping(value *C.GValue, variant *C.GVariant, userData C.gpointer) C.gboolean {
+	settingsBindMappingRegistry.RLock()
+	mapping := settingsBindMappingRegistry.m[int(uintptr(userData))]
+	settingsBindMappingRegistry.RUnlock()
+
+	property, ok := mapping.get(WrapVariant(unsafe.Pointer(variant)))
+	if !ok {
+		return C.FALSE
+	}
+	gvalue, err := GValue(property)
+	if err != nil {
+		return C.FALSE
+	}
+	// value is initialized to the type of the property.
+	return C.g_value_transform(gvalue.native(), value)
+}
+
+//export goSettingsBindSetMapping
+func goSettingsBindSetMapping(value *C.GValue, expectedType *C.GVariantType,
+	userData C.gpointer) *C.GVariant {
+	settingsBindMappingRegistry.RLock()
+	mapping := settingsBindMappingRegistry.m[int(uintptr(userData))]
+	settingsBindMappingRegistry.RUnlock()
+
+	property, err := (&Value{value}).GoValue()
+	if err != nil {
+		return nil
+	}
+	variant := mapping.set(property, newVariantType(expectedType))
+	if variant == nil {
+		return nil
+	}
+	// The caller takes its own reference of the returned value.
+	return C.g_variant_ref(variant.native())
+}
+
+//export goSettingsBindMappingDestroy
+func goSettingsBindMappingDestroy(userData C.gpointer) {
+	settingsBindMappingRegistry.Lock()
+	delete(settingsBindMappingRegistry.m, int(uintptr(userData)))
+	settingsBindMappingRegistry.Unlock()
+}
+
+// BindWritable is a wrapper around g_settings_bind_writable().
+// The boolean property, such as "sensitive", tracks whether key is
+// writable, or is not writable if inverted is true.
+func (v *Settings) BindWritable(key string, object IObject, property string, inverted bool) {
+	ckey := C.CString(key)
+	defer C.free(unsafe.Pointer(ckey))
+	cproperty := C.CString(property)
+	defer C.free(unsafe.Pointer(cproperty))
+
+	C.g_settings_bind_writable(v.native(), (*C.gchar)(ckey),
+		C.gpointer(unsafe.Pointer(object.toObject().native())),
+		(*C.gchar)(cproperty), gbool(inverted))
+}
+
 // void 	g_settings_unbind ()
 func (v *Settings) Unbind(object IObject, property string) {
 	cproperty := C.CString(property)
@@ -365,9 +484,35 @@ func (v *Settings) SetStrv(key string, value []string) bool {
 	return gobool(c)
 }
 
+// GetUserValue is a wrapper around g_settings_get_user_value().
+// nil is returned if the user did not set key, so that its value is
+// the default one.
+func (v *Settings) GetUserValue(key string) *Variant {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_settings_get_user_value(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	return wrapVariantFull(c)
+}
+
+// GetDefaultValue is a wrapper around g_settings_get_default_value().
+// It returns the value key would have if the user did not set it, which
+// takes the default values set by the system administrator into account.
+func (v *Settings) GetDefaultValue(key string) *Variant {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_settings_get_default_value(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	return wrapVariantFull(c)
+}
+
 // gchar ** 	g_settings_list_keys ()
-// GVariant * 	g_settings_get_user_value ()
-// GVariant * 	g_settings_get_default_value ()
 // const gchar * const * 	g_settings_list_schemas ()
 // const gchar * const * 	g_settings_list_relocatable_schemas ()
 // GVariant * 	g_settings_get_range ()
@@ -375,9 +520,44 @@ func (v *Settings) SetStrv(key string, value []string) bool {
 // void 	g_settings_get ()
 // gboolean 	g_settings_set ()
 // gpointer 	g_settings_get_mapped ()
-// void 	g_settings_bind_with_mapping ()
-// void 	g_settings_bind_writable ()
-// gaction * 	g_settings_create_action ()
+
+// CreateAction is a wrapper around g_settings_create_action().
+// The stateful action has the name of key and its value as state;
+// activating it toggles boolean keys, and sets other keys to its
+// parameter.
+func (v *Settings) CreateAction(key string) *Action {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_settings_create_action(v.native(), (*C.gchar)(cstr))
+	return takeAction(c)
+}
+
+// ConnectChanged connects f to the "changed" signal of the settings,
+// emitted with the name of the changed key. If key is not empty, f is
+// only called for changes of key, through the "changed::key" signal.
+func (v *Settings) ConnectChanged(key string, f func(key string)) (SignalHandle, error) {
+	signal := "changed"
+	if key != "" {
+		signal += "::" + key
+	}
+	return v.Connect(signal, func(_ interface{}, key string) {
+		f(key)
+	})
+}
+
+// ConnectWritableChanged connects f to the "writable-changed" signal of
+// the settings, emitted with the name of the key whose writability
+// changed. If key is not empty, f is only called for key.
+func (v *Settings) ConnectWritableChanged(key string, f func(key string)) (SignalHandle, error) {
+	signal := "writable-changed"
+	if key != "" {
+		signal += "::" + key
+	}
+	return v.Connect(signal, func(_ interface{}, key string) {
+		f(key)
+	})
+}
 
 // SettingsSchema is a representation of GSettingsSchema.
 type SettingsSchema struct {
@@ -448,17 +628,140 @@ func (v *SettingsSchema) HasKey(v1 string) bool {
 // 	return toGoStringArray(C.g_settings_schema_list_keys(v.native()))
 // }
 
-// const GVariantType * 	g_settings_schema_key_get_value_type ()
-// GVariant * 	g_settings_schema_key_get_default_value ()
-// GVariant * 	g_settings_schema_key_get_range ()
-// gboolean 	g_settings_schema_key_range_check ()
-// const gchar * 	g_settings_schema_key_get_name ()
-// const gchar * 	g_settings_schema_key_get_summary ()
-// const gchar * 	g_settings_schema_key_get_description ()
+// GetKey is a wrapper around g_settings_schema_get_key().
+// nil is returned if the schema has no key named name.
+func (v *SettingsSchema) GetKey(name string) *SettingsSchemaKey {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	if !gobool(C.g_settings_schema_has_key(v.native(), (*C.gchar)(cstr))) {
+		return nil
+	}
+	return wrapSettingsSchemaKey(C.g_settings_schema_get_key(v.native(), (*C.gchar)(cstr)))
+}
+
+// SettingsSchemaKey is a representation of GSettingsSchemaKey,
+// which describes a key of a SettingsSchema.
+type SettingsSchemaKey struct {
+	key *C.GSettingsSchemaKey
+}
+
+// wrapSettingsSchemaKey wraps a full reference to a GSettingsSchemaKey,
+// which is released once the SettingsSchemaKey is garbage collected.
+func wrapSettingsSchemaKey(key *C.GSettingsSchemaKey) *SettingsSchemaKey {
+	v := &SettingsSchemaKey{key}
+	runtime.SetFinalizer(v, func(v *SettingsSchemaKey) {
+		C.g_settings_schema_key_unref(v.key)
+	})
+	return v
+}
+
+func (v *SettingsSchemaKey) Native() uintptr {
+	return uintptr(unsafe.Pointer(v.native()))
+}
+
+func (v *SettingsSchemaKey) native() *C.GSettingsSchemaKey {
+	if v == nil {
+		return nil
+	}
+	return v.key
+}
+
+func marshalSettingsSchemaKey(p uintptr) (interface{}, error) {
+	c := C.g_value_get_boxed((*C.GValue)(unsafe.Pointer(p)))
+	key := (*C.GSettingsSchemaKey)(unsafe.Pointer(c))
+	return wrapSettingsSchemaKey(C.g_settings_schema_key_ref(key)), nil
+}
 
-// GSettingsSchemaKey * 	g_settings_schema_get_key ()
-// GSettingsSchemaKey * 	g_settings_schema_key_ref ()
-// void 	g_settings_schema_key_unref ()
+// GetSummary is a wrapper around g_settings_schema_key_get_summary().
+// The summary is translated, and empty if the schema has none.
+func (v *SettingsSchemaKey) GetSummary() string {
+	c := C.g_settings_schema_key_get_summary(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// GetDescription is a wrapper around g_settings_schema_key_get_description().
+// The description is translated, and empty if the schema has none.
+func (v *SettingsSchemaKey) GetDescription() string {
+	c := C.g_settings_schema_key_get_description(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// GetValueType is a wrapper around g_settings_schema_key_get_value_type().
+// The type is copied, as it belongs to the key.
+func (v *SettingsSchemaKey) GetValueType() *VariantType {
+	return copyVariantType(C.g_settings_schema_key_get_value_type(v.native()))
+}
+
+// GetDefaultValue is a wrapper around g_settings_schema_key_get_default_value().
+// It returns the default value of the schema, unlike Settings.GetDefaultValue
+// which also takes overrides set by the system administrator into account.
+func (v *SettingsSchemaKey) GetDefaultValue() *Variant {
+	return wrapVariantFull(C.g_settings_schema_key_get_default_value(v.native()))
+}
+
+// SettingsRangeType is the kind of a SettingsRange.
+type SettingsRangeType string
+
+const (
+	// Any value of the type of the key is allowed.
+	SETTINGS_RANGE_TYPE SettingsRangeType = "type"
+	// The value is one of the choices.
+	SETTINGS_RANGE_ENUM SettingsRangeType = "enum"
+	// The value is a list of choices.
+	SETTINGS_RANGE_FLAGS SettingsRangeType = "flags"
+	// The value is between a minimum and a maximum.
+	SETTINGS_RANGE_RANGE SettingsRangeType = "range"
+)
+
+// SettingsRange describes the values allowed for a SettingsSchemaKey.
+type SettingsRange struct {
+	Type SettingsRangeType
+	// Choices holds the nicks of the values of "enum" and "flags" ranges.
+	Choices []string
+	// Min and Max bound the values of "range" ranges, and are nil for
+	// other ranges.
+	Min, Max *Variant
+}
+
+// GetRange is a wrapper around g_settings_schema_key_get_range().
+// The range is decoded from its (sv) variant.
+func (v *SettingsSchemaKey) GetRange() *SettingsRange {
+	c := C.g_settings_schema_key_get_range(v.native())
+	defer C.g_variant_unref(c)
+
+	ctype := C.g_variant_get_child_value(c, 0)
+	defer C.g_variant_unref(ctype)
+	boxed := C.g_variant_get_child_value(c, 1)
+	defer C.g_variant_unref(boxed)
+	value := C.g_variant_get_variant(boxed)
+	defer C.g_variant_unref(value)
+
+	r := &SettingsRange{Type: SettingsRangeType(goString(C.g_variant_get_string(ctype, nil)))}
+	switch r.Type {
+	case SETTINGS_RANGE_ENUM, SETTINGS_RANGE_FLAGS:
+		choices := C.g_variant_get_strv(value, nil)
+		// Only the container is owned by the caller.
+		defer C.g_free(C.gpointer(choices))
+		r.Choices = goStringArray(choices)
+	case SETTINGS_RANGE_RANGE:
+		r.Min = wrapVariantFull(C.g_variant_get_child_value(value, 0))
+		r.Max = wrapVariantFull(C.g_variant_get_child_value(value, 1))
+	}
+	return r
+}
+
+// RangeCheck is a wrapper around g_settings_schema_key_range_check().
+// value must be of the type of the key.
+func (v *SettingsSchemaKey) RangeCheck(value *Variant) bool {
+	return gobool(C.g_settings_schema_key_range_check(v.native(), value.native()))
+}
 
 // SettingsSchemaSource is a representation of GSettingsSchemaSource.
 type SettingsSchemaSource struct {
@@ -505,9 +808,10 @@ func SettingsSchemaSourceNewFromDirectory(dir string, parent *SettingsSchemaSour
 	cstr := C.CString(dir)
 	defer C.free(unsafe.Pointer(cstr))
 
-	c := C.g_settings_schema_source_new_from_directory((*C.gchar)(cstr), parent.native(), gbool(trusted), nil)
+	var err *C.GError
+	c := C.g_settings_schema_source_new_from_directory((*C.gchar)(cstr), parent.native(), gbool(trusted), &err)
 	if c == nil {
-		return nil, errNilPtr
+		return nil, takeError(err)
 	}
 	return wrapSettingsSchemaSource(c), nil
 }
@@ -606,17 +910,68 @@ func NullSettingsBackendNew() (*SettingsBackend, error) {
 	return wrapSettingsBackend(wrapObject(unsafe.Pointer(c))), nil
 }
 
-// void 	g_settings_backend_changed ()
-// void 	g_settings_backend_path_changed ()
-// void 	g_settings_backend_keys_changed ()
-// void 	g_settings_backend_path_writable_changed ()
-// void 	g_settings_backend_writable_changed ()
+// Changed is a wrapper around g_settings_backend_changed().
+// It notifies the Settings using the backend that key changed.
+func (v *SettingsBackend) Changed(key string) {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_settings_backend_changed(v.native(), (*C.gchar)(cstr), nil)
+}
+
+// PathChanged is a wrapper around g_settings_backend_path_changed().
+// It notifies that any key under path, which ends with a slash,
+// may have changed.
+func (v *SettingsBackend) PathChanged(path string) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_settings_backend_path_changed(v.native(), (*C.gchar)(cstr), nil)
+}
+
+// KeysChanged is a wrapper around g_settings_backend_keys_changed().
+// It notifies that the keys of items, relative to path, changed.
+func (v *SettingsBackend) KeysChanged(path string, items []string) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	citems := C.make_strings(C.int(len(items) + 1))
+	defer C.destroy_strings(citems)
+
+	for i, item := range items {
+		citem := C.CString(item)
+		defer C.free(unsafe.Pointer(citem))
+		C.set_string(citems, C.int(i), citem)
+	}
+	C.set_string(citems, C.int(len(items)), nil)
+
+	C.g_settings_backend_keys_changed(v.native(), (*C.gchar)(cstr),
+		(**C.gchar)(unsafe.Pointer(citems)), nil)
+}
+
+// WritableChanged is a wrapper around g_settings_backend_writable_changed().
+func (v *SettingsBackend) WritableChanged(key string) {
+	cstr := C.CString(key)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_settings_backend_writable_changed(v.native(), (*C.gchar)(cstr))
+}
+
+// PathWritableChanged is a wrapper around g_settings_backend_path_writable_changed().
+func (v *SettingsBackend) PathWritableChanged(path string) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_settings_backend_path_writable_changed(v.native(), (*C.gchar)(cstr))
+}
+
 // void 	g_settings_backend_changed_tree ()
 // void 	g_settings_backend_flatten_tree ()
 
 func init() {
 	tm := []TypeMarshaler{
 		{Type(C.g_settings_schema_get_type()), marshalSettingsSchema},
+		{Type(C.g_settings_schema_key_get_type()), marshalSettingsSchemaKey},
 	}
 	RegisterGValueMarshalers(tm)
 }
@@ -0,0 +1,30 @@
+// Same copyright and license as the rest of the files in this project
+
+// GSettings mapped bindings
+// See: https://developer.gnome.org/gio/stable/GSettings.html
+
+#ifndef __GSETTINGS_GO_H__
+#define __GSETTINGS_GO_H__
+
+#include <gio/gio.h>
+
+extern gboolean goSettingsBindGetMapping(GValue *value, GVariant *variant,
+                                         gpointer user_data);
+extern GVariant *goSettingsBindSetMapping(GValue *value,
+                                          GVariantType *expected_type,
+                                          gpointer user_data);
+extern void goSettingsBindMappingDestroy(gpointer user_data);
+
+static GSettingsBindGetMapping
+_go_settings_bind_get_mapping(void)
+{
+	return ((GSettingsBindGetMapping)goSettingsBindGetMapping);
+}
+
+static GSettingsBindSetMapping
+_go_settings_bind_set_mapping(void)
+{
+	return ((GSettingsBindSetMapping)goSettingsBindSetMapping);
+}
+
+#endif
@@ -0,0 +1,155 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "settings_backend.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+)
+
+// SettingsBackendImplementation is implemented by Go values storing the
+// settings of a SettingsBackend created with SettingsBackendNew. Keys
+// are absolute paths, such as "/org/example/app/window-width". Methods
+// are called from whichever thread reads or writes a Settings using the
+// backend, so they must be safe to call concurrently.
+type SettingsBackendImplementation interface {
+	// Read returns the value of key, or nil if it is not set, in which
+	// case the default value of the schema is used. Values which are
+	// not of expectedType are ignored. If defaultValue is true, the
+	// default value set by the system administrator is asked for, which
+	// most backends do not have.
+	Read(key string, expectedType *VariantType, defaultValue bool) *Variant
+	// Write stores value as the value of key, and returns whether it
+	// succeeded.
+	Write(key string, value *Variant) bool
+	// Reset removes the value of key, so that it has its default value.
+	Reset(key string)
+	// GetWritable returns whether key may be written.
+	GetWritable(key string) bool
+	// Subscribe is called once a Settings watches the keys found under
+	// name, which is a path ending with a slash. Backends whose storage
+	// may be changed by other processes should start watching it for
+	// changes, and report them with the Changed methods of the backend.
+	Subscribe(name string)
+	// Unsubscribe reverts a call to Subscribe.
+	Unsubscribe(name string)
+	// Sync flushes the pending writes to the storage.
+	Sync()
+}
+
+var (
+	settingsBackendRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]SettingsBackendImplementation
+	}{
+		next: 1,
+		m:    make(map[int]SettingsBackendImplementation),
+	}
+)
+
+// SettingsBackendNew creates a SettingsBackend storing settings with impl,
+// to be passed to SettingsNewWithBackend. Writes and resets done through
+// Settings are notified by the backend itself; changes made to the
+// storage by other means must be reported with the Changed methods.
+func SettingsBackendNew(impl SettingsBackendImplementation) (*SettingsBackend, error) {
+	settingsBackendRegistry.Lock()
+	id := settingsBackendRegistry.next
+	settingsBackendRegistry.next++
+	settingsBackendRegistry.m[id] = impl
+	settingsBackendRegistry.Unlock()
+
+	c := C._gotk3_settings_backend_new(C.gpointer(uintptr(id)))
+	if c == nil {
+		goSettingsBackendFinalize(C.gpointer(uintptr(id)))
+		return nil, errNilPtr
+	}
+
+	obj := wrapObject(unsafe.Pointer(c))
+	// The backend is created with a full reference,
+	// which is released once it is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSettingsBackend(obj), nil
+}
+
+func settingsBackendImplementation(id C.gpointer) SettingsBackendImplementation {
+	settingsBackendRegistry.RLock()
+	defer settingsBackendRegistry.RUnlock()
+
+	return settingsBackendRegistry.m[int(uintptr(id))]
+}
+
+//export goSettingsBackendRead
+func goSettingsBackendRead(id C.gpointer, key *C.gchar, expectedType *C.GVariantType,
+	defaultValue C.gboolean) *C.GVariant {
+	impl := settingsBackendImplementation(id)
+	if impl == nil {
+		return nil
+	}
+	value := impl.Read(goString(key), newVariantType(expectedType), gobool(defaultValue))
+	if value == nil {
+		return nil
+	}
+	// read() returns a full reference.
+	return C.g_variant_ref(value.native())
+}
+
+//export goSettingsBackendGetWritable
+func goSettingsBackendGetWritable(id C.gpointer, key *C.gchar) C.gboolean {
+	impl := settingsBackendImplementation(id)
+	if impl == nil {
+		return C.FALSE
+	}
+	return gbool(impl.GetWritable(goString(key)))
+}
+
+//export goSettingsBackendWrite
+func goSettingsBackendWrite(id C.gpointer, key *C.gchar, value *C.GVariant) C.gboolean {
+	impl := settingsBackendImplementation(id)
+	if impl == nil {
+		return C.FALSE
+	}
+	return gbool(impl.Write(goString(key), WrapVariant(unsafe.Pointer(value))))
+}
+
+//export goSettingsBackendReset
+func goSettingsBackendReset(id C.gpointer, key *C.gchar) {
+	if impl := settingsBackendImplementation(id); impl != nil {
+		impl.Reset(goString(key))
+	}
+}
+
+//export goSettingsBackendSubscribe
+func goSettingsBackendSubscribe(id C.gpointer, name *C.gchar) {
+	if impl := settingsBackendImplementation(id); impl != nil {
+		impl.Subscribe(goString(name))
+	}
+}
+
+//export goSettingsBackendUnsubscribe
+func goSettingsBackendUnsubscribe(id C.gpointer, name *C.gchar) {
+	if impl := settingsBackendImplementation(id); impl != nil {
+		impl.Unsubscribe(goString(name))
+	}
+}
+
+//export goSettingsBackendSync
+func goSettingsBackendSync(id C.gpointer) {
+	if impl := settingsBackendImplementation(id); impl != nil {
+		impl.Sync()
+	}
+}
+
+//export goSettingsBackendFinalize
+func goSettingsBackendFinalize(id C.gpointer) {
+	settingsBackendRegistry.Lock()
+	delete(settingsBackendRegistry.m, int(uintptr(id)))
+	settingsBackendRegistry.Unlock()
+}
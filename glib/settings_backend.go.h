@@ -0,0 +1,203 @@
+// Same copyright and license as the rest of the files in this project
+
+// GSettingsBackend implemented in Go
+// See: https://developer.gnome.org/gio/stable/GSettingsBackend.html
+
+#ifndef __GSETTINGS_BACKEND_GO_H__
+#define __GSETTINGS_BACKEND_GO_H__
+
+#include <gio/gio.h>
+#ifndef G_SETTINGS_ENABLE_BACKEND
+#define G_SETTINGS_ENABLE_BACKEND
+#endif
+#include <gio/gsettingsbackend.h>
+
+extern GVariant *goSettingsBackendRead(gpointer id, gchar *key,
+                                       GVariantType *expected_type,
+                                       gboolean default_value);
+extern gboolean goSettingsBackendGetWritable(gpointer id, gchar *key);
+extern gboolean goSettingsBackendWrite(gpointer id, gchar *key,
+                                       GVariant *value);
+extern void goSettingsBackendReset(gpointer id, gchar *key);
+extern void goSettingsBackendSubscribe(gpointer id, gchar *name);
+extern void goSettingsBackendUnsubscribe(gpointer id, gchar *name);
+extern void goSettingsBackendSync(gpointer id);
+extern void goSettingsBackendFinalize(gpointer id);
+
+/*
+ * Gotk3SettingsBackend is a GSettingsBackend implemented by a Go value,
+ * which is referenced by id from the Go side. Change notifications of
+ * writes and resets are emitted here, once the Go value succeeded.
+ */
+
+typedef struct {
+	GSettingsBackend parent_instance;
+	gpointer id;
+} Gotk3SettingsBackend;
+
+typedef struct {
+	GSettingsBackendClass parent_class;
+} Gotk3SettingsBackendClass;
+
+static GObjectClass *gotk3_settings_backend_parent_class = NULL;
+
+#define GOTK3_SETTINGS_BACKEND_ID(backend) \
+	(((Gotk3SettingsBackend *)(backend))->id)
+
+static GVariant *
+gotk3_settings_backend_read(GSettingsBackend *backend, const gchar *key,
+                            const GVariantType *expected_type,
+                            gboolean default_value)
+{
+	return (goSettingsBackendRead(GOTK3_SETTINGS_BACKEND_ID(backend),
+	    (gchar *)key, (GVariantType *)expected_type, default_value));
+}
+
+static gboolean
+gotk3_settings_backend_get_writable(GSettingsBackend *backend,
+                                    const gchar *key)
+{
+	return (goSettingsBackendGetWritable(GOTK3_SETTINGS_BACKEND_ID(backend),
+	    (gchar *)key));
+}
+
+static gboolean
+gotk3_settings_backend_write(GSettingsBackend *backend, const gchar *key,
+                             GVariant *value, gpointer origin_tag)
+{
+	gboolean success;
+
+	success = goSettingsBackendWrite(GOTK3_SETTINGS_BACKEND_ID(backend),
+	    (gchar *)key, value);
+	if (success)
+		g_settings_backend_changed(backend, key, origin_tag);
+	return (success);
+}
+
+typedef struct {
+	gpointer id;
+	gboolean success;
+} Gotk3SettingsBackendTreeData;
+
+static gboolean
+gotk3_settings_backend_check_one(gpointer key, gpointer value, gpointer data)
+{
+	Gotk3SettingsBackendTreeData *tree_data = data;
+
+	tree_data->success = goSettingsBackendGetWritable(tree_data->id, key);
+	/* Stop at the first key which is not writable. */
+	return (!tree_data->success);
+}
+
+static gboolean
+gotk3_settings_backend_write_one(gpointer key, gpointer value, gpointer data)
+{
+	Gotk3SettingsBackendTreeData *tree_data = data;
+
+	/* A NULL value resets the key. */
+	if (value == NULL)
+		goSettingsBackendReset(tree_data->id, key);
+	else if (!goSettingsBackendWrite(tree_data->id, key, value))
+		tree_data->success = FALSE;
+	return (FALSE);
+}
+
+static gboolean
+gotk3_settings_backend_write_tree(GSettingsBackend *backend, GTree *tree,
+                                  gpointer origin_tag)
+{
+	Gotk3SettingsBackendTreeData tree_data = {
+		GOTK3_SETTINGS_BACKEND_ID(backend), TRUE
+	};
+
+	/* Nothing is written unless every key is writable. */
+	g_tree_foreach(tree, gotk3_settings_backend_check_one, &tree_data);
+	if (!tree_data.success)
+		return (FALSE);
+
+	g_tree_foreach(tree, gotk3_settings_backend_write_one, &tree_data);
+	g_settings_backend_changed_tree(backend, tree, origin_tag);
+	return (tree_data.success);
+}
+
+static void
+gotk3_settings_backend_reset(GSettingsBackend *backend, const gchar *key,
+                             gpointer origin_tag)
+{
+	goSettingsBackendReset(GOTK3_SETTINGS_BACKEND_ID(backend), (gchar *)key);
+	g_settings_backend_changed(backend, key, origin_tag);
+}
+
+static void
+gotk3_settings_backend_subscribe(GSettingsBackend *backend, const gchar *name)
+{
+	goSettingsBackendSubscribe(GOTK3_SETTINGS_BACKEND_ID(backend),
+	    (gchar *)name);
+}
+
+static void
+gotk3_settings_backend_unsubscribe(GSettingsBackend *backend,
+                                   const gchar *name)
+{
+	goSettingsBackendUnsubscribe(GOTK3_SETTINGS_BACKEND_ID(backend),
+	    (gchar *)name);
+}
+
+static void
+gotk3_settings_backend_sync(GSettingsBackend *backend)
+{
+	goSettingsBackendSync(GOTK3_SETTINGS_BACKEND_ID(backend));
+}
+
+static void
+gotk3_settings_backend_finalize(GObject *object)
+{
+	goSettingsBackendFinalize(GOTK3_SETTINGS_BACKEND_ID(object));
+	gotk3_settings_backend_parent_class->finalize(object);
+}
+
+static void
+gotk3_settings_backend_class_init(gpointer g_class, gpointer class_data)
+{
+	GSettingsBackendClass *backend_class = g_class;
+
+	gotk3_settings_backend_parent_class = g_type_class_peek_parent(g_class);
+	G_OBJECT_CLASS(g_class)->finalize = gotk3_settings_backend_finalize;
+
+	backend_class->read = gotk3_settings_backend_read;
+	backend_class->get_writable = gotk3_settings_backend_get_writable;
+	backend_class->write = gotk3_settings_backend_write;
+	backend_class->write_tree = gotk3_settings_backend_write_tree;
+	backend_class->reset = gotk3_settings_backend_reset;
+	backend_class->subscribe = gotk3_settings_backend_subscribe;
+	backend_class->unsubscribe = gotk3_settings_backend_unsubscribe;
+	backend_class->sync = gotk3_settings_backend_sync;
+}
+
+static GType
+gotk3_settings_backend_get_type(void)
+{
+	static gsize type_id = 0;
+
+	if (g_once_init_enter(&type_id)) {
+		GType t = g_type_register_static_simple(G_TYPE_SETTINGS_BACKEND,
+			g_intern_static_string("Gotk3SettingsBackend"),
+			sizeof(Gotk3SettingsBackendClass),
+			gotk3_settings_backend_class_init,
+			sizeof(Gotk3SettingsBackend), NULL, 0);
+		g_once_init_leave(&type_id, t);
+	}
+	return (type_id);
+}
+
+static GSettingsBackend *
+_gotk3_settings_backend_new(gpointer id)
+{
+	Gotk3SettingsBackend *self = g_object_new(
+	    gotk3_settings_backend_get_type(), NULL);
+
+	self->id = id;
+	return (G_SETTINGS_BACKEND(self));
+}
+
+#endif
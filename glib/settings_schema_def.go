@@ -0,0 +1,330 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// SettingsSchemaDefinition declares a GSettings schema from a Go struct,
+// so that applications do not need to ship and install .gschema.xml files.
+//
+// Each exported field of Keys, which is a struct or a pointer to a struct,
+// declares a key. The type of the key follows the type of the field:
+//
+//	bool      "b"     int16   "n"     uint16  "q"     float64   "d"
+//	uint8     "y"     int32   "i"     uint32  "u"     string    "s"
+//	                  int64   "x"     uint64  "t"     []string  "as"
+//
+// int and uint are declared as "x" and "t". The value of the field is the
+// default value of the key. Fields are described with tags:
+//
+//	gsettings:"name"     the name of the key, derived from the field
+//	                     name if empty, like "window-width" for
+//	                     WindowWidth; "-" skips the field
+//	default:"value"      the default value, overriding the field value,
+//	                     with []string items separated by commas
+//	range:"min,max"      the bounds of numeric keys
+//	choices:"a,b,c"      the values allowed for string keys
+//	summary:"text"       a short description of the key
+//	description:"text"   a longer description of the key
+//
+// For example:
+//
+//	type Preferences struct {
+//		WindowWidth int32  `range:"100,4000" summary:"Width of the main window"`
+//		Theme       string `choices:"system,light,dark"`
+//	}
+//
+//	def := glib.SettingsSchemaDefinition{
+//		ID:   "org.example.App",
+//		Path: "/org/example/App/",
+//		Keys: Preferences{WindowWidth: 800, Theme: "system"},
+//	}
+type SettingsSchemaDefinition struct {
+	// ID is the identifier of the schema, like "org.example.App".
+	ID string
+	// Path is where the keys are stored, like "/org/example/App/".
+	// The schema is relocatable if Path is empty.
+	Path string
+	// Keys is a struct, or a pointer to a struct, declaring the keys.
+	Keys interface{}
+}
+
+type gschemaList struct {
+	XMLName       xml.Name  `xml:"schemalist"`
+	GettextDomain string    `xml:"gettext-domain,attr,omitempty"`
+	Schemas       []gschema `xml:"schema"`
+}
+
+type gschema struct {
+	ID   string       `xml:"id,attr"`
+	Path string       `xml:"path,attr,omitempty"`
+	Keys []gschemaKey `xml:"key"`
+}
+
+type gschemaKey struct {
+	Name        string          `xml:"name,attr"`
+	Type        string          `xml:"type,attr"`
+	Range       *gschemaRange   `xml:"range"`
+	Choices     *gschemaChoices `xml:"choices"`
+	Default     string          `xml:"default"`
+	Summary     string          `xml:"summary,omitempty"`
+	Description string          `xml:"description,omitempty"`
+}
+
+type gschemaRange struct {
+	Min string `xml:"min,attr"`
+	Max string `xml:"max,attr"`
+}
+
+type gschemaChoices struct {
+	Choices []gschemaValue `xml:"choice"`
+}
+
+type gschemaValue struct {
+	Value string `xml:"value,attr"`
+}
+
+// settingsKeyTypes maps the kinds of struct fields to the types of keys.
+var settingsKeyTypes = map[reflect.Kind]string{
+	reflect.Bool:    "b",
+	reflect.Uint8:   "y",
+	reflect.Int16:   "n",
+	reflect.Uint16:  "q",
+	reflect.Int32:   "i",
+	reflect.Uint32:  "u",
+	reflect.Int64:   "x",
+	reflect.Int:     "x",
+	reflect.Uint64:  "t",
+	reflect.Uint:    "t",
+	reflect.Float64: "d",
+	reflect.String:  "s",
+}
+
+// settingsKeyName converts a field name like "WindowWidth", "URLList" or
+// "RecentURLs" to a key name like "window-width", "url-list" or
+// "recent-urls".
+func settingsKeyName(field string) string {
+	runes := []rune(field)
+	isPlural := func(i int) bool {
+		return runes[i] == 's' && (i+1 == len(runes) || unicode.IsUpper(runes[i+1]))
+	}
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// A word starts at an upper case letter which follows a
+			// lower case one, or which starts a word after an acronym.
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) ||
+				(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && !isPlural(i+1))) {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// quoteSettingsString quotes s as a GVariant text format string.
+func quoteSettingsString(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `'`, `\'`, -1)
+	return "'" + s + "'"
+}
+
+// settingsDefault formats the default value of a key in the GVariant
+// text format, from the default tag if any, or else from value.
+func settingsDefault(value reflect.Value, tag string, hasTag bool) (string, error) {
+	if value.Kind() == reflect.Slice {
+		var items []string
+		if hasTag {
+			if tag != "" {
+				items = strings.Split(tag, ",")
+			}
+		} else {
+			items = value.Interface().([]string)
+		}
+		quoted := make([]string, len(items))
+		for i, item := range items {
+			quoted[i] = quoteSettingsString(item)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]", nil
+	}
+
+	if !hasTag {
+		switch value.Kind() {
+		case reflect.String:
+			return quoteSettingsString(value.String()), nil
+		case reflect.Float64:
+			return strconv.FormatFloat(value.Float(), 'g', -1, 64), nil
+		default:
+			return fmt.Sprint(value.Interface()), nil
+		}
+	}
+
+	// Check the tag, since the schema compiler errors are not
+	// related to the struct, and write it out again, since Go
+	// accepts values like "TRUE" which GVariant does not.
+	switch value.Kind() {
+	case reflect.String:
+		return quoteSettingsString(tag), nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(tag)
+		return strconv.FormatBool(b), err
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(tag, 64)
+		return strconv.FormatFloat(f, 'g', -1, 64), err
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uint:
+		u, err := strconv.ParseUint(tag, 10, value.Type().Bits())
+		return strconv.FormatUint(u, 10), err
+	default:
+		i, err := strconv.ParseInt(tag, 10, value.Type().Bits())
+		return strconv.FormatInt(i, 10), err
+	}
+}
+
+// schema converts the definition to its XML representation.
+func (d SettingsSchemaDefinition) schema() (gschema, error) {
+	schema := gschema{ID: d.ID, Path: d.Path}
+
+	value := reflect.ValueOf(d.Keys)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return schema, fmt.Errorf("schema %s: keys must be declared by a struct, not %T",
+			d.ID, d.Keys)
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		key := gschemaKey{
+			Name:        field.Tag.Get("gsettings"),
+			Summary:     field.Tag.Get("summary"),
+			Description: field.Tag.Get("description"),
+		}
+		if key.Name == "-" {
+			continue
+		}
+		if key.Name == "" {
+			key.Name = settingsKeyName(field.Name)
+		}
+
+		if field.Type == reflect.TypeOf([]string(nil)) {
+			key.Type = "as"
+		} else if t, ok := settingsKeyTypes[field.Type.Kind()]; ok {
+			key.Type = t
+		} else {
+			return schema, fmt.Errorf("schema %s: key %s: unsupported type %s",
+				d.ID, key.Name, field.Type)
+		}
+
+		tag, hasTag := field.Tag.Lookup("default")
+		def, err := settingsDefault(value.Field(i), tag, hasTag)
+		if err != nil {
+			return schema, fmt.Errorf("schema %s: key %s: invalid default %q: %v",
+				d.ID, key.Name, tag, err)
+		}
+		key.Default = def
+
+		if r, ok := field.Tag.Lookup("range"); ok {
+			bounds := strings.Split(r, ",")
+			if len(bounds) != 2 {
+				return schema, fmt.Errorf("schema %s: key %s: invalid range %q",
+					d.ID, key.Name, r)
+			}
+			key.Range = &gschemaRange{strings.TrimSpace(bounds[0]), strings.TrimSpace(bounds[1])}
+		}
+		if choices, ok := field.Tag.Lookup("choices"); ok {
+			key.Choices = &gschemaChoices{}
+			for _, choice := range strings.Split(choices, ",") {
+				key.Choices.Choices = append(key.Choices.Choices,
+					gschemaValue{strings.TrimSpace(choice)})
+			}
+		}
+
+		schema.Keys = append(schema.Keys, key)
+	}
+	return schema, nil
+}
+
+// SettingsSchemaXML returns the .gschema.xml document declaring schemas.
+// gettextDomain, which may be empty, is the domain used to translate the
+// summaries and descriptions of the keys.
+func SettingsSchemaXML(gettextDomain string, schemas ...SettingsSchemaDefinition) ([]byte, error) {
+	list := gschemaList{GettextDomain: gettextDomain}
+	for _, d := range schemas {
+		schema, err := d.schema()
+		if err != nil {
+			return nil, err
+		}
+		list.Schemas = append(list.Schemas, schema)
+	}
+
+	data, err := xml.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// SettingsSchemaCompile writes the .gschema.xml files of schemas to dir,
+// which is created if needed, and compiles them with glib-compile-schemas,
+// which must be found in the PATH. gettextDomain is passed along to
+// SettingsSchemaXML.
+func SettingsSchemaCompile(dir, gettextDomain string, schemas ...SettingsSchemaDefinition) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, d := range schemas {
+		data, err := SettingsSchemaXML(gettextDomain, d)
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, d.ID+".gschema.xml"), data, 0644)
+		if err != nil {
+			return err
+		}
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("glib-compile-schemas", "--strict", dir)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("glib-compile-schemas: %v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// SettingsSchemaSourceNewFromDefinitions compiles schemas to dir with
+// SettingsSchemaCompile, and returns a SettingsSchemaSource loading them
+// from dir, whose parent is the default source. This lets applications
+// run without schemas installed system wide, and tests use temporary
+// schemas:
+//
+//	source, err := glib.SettingsSchemaSourceNewFromDefinitions(t.TempDir(), "", def)
+//	...
+//	settings, err := glib.SettingsNewFull(source.Lookup(def.ID, false), nil, "")
+func SettingsSchemaSourceNewFromDefinitions(dir, gettextDomain string,
+	schemas ...SettingsSchemaDefinition) (*SettingsSchemaSource, error) {
+	if err := SettingsSchemaCompile(dir, gettextDomain, schemas...); err != nil {
+		return nil, err
+	}
+	return SettingsSchemaSourceNewFromDirectory(dir, SettingsSchemaSourceGetDefault(), true)
+}
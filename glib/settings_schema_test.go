@@ -0,0 +1,120 @@
+package glib_test
+
+import (
+	"bytes"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+type testPreferences struct {
+	WindowWidth int32    `range:"100,4000" summary:"Width of the main window"`
+	Theme       string   `choices:"system,light,dark"`
+	RecentURLs  []string `default:"a,b"`
+	ShowHidden  bool
+	Internal    int `gsettings:"-"`
+}
+
+func TestSettingsSchemaSourceNewFromDefinitions(t *testing.T) {
+	if _, err := exec.LookPath("glib-compile-schemas"); err != nil {
+		t.Skip("glib-compile-schemas not found")
+	}
+
+	def := glib.SettingsSchemaDefinition{
+		ID:   "org.gotk3.Test",
+		Path: "/org/gotk3/Test/",
+		Keys: testPreferences{WindowWidth: 800, Theme: "system"},
+	}
+	source, err := glib.SettingsSchemaSourceNewFromDefinitions(t.TempDir(), "", def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := source.Lookup(def.ID, false)
+	if schema == nil {
+		t.Fatalf("Schema %s not found", def.ID)
+	}
+	if schema.HasKey("internal") {
+		t.Error("Expected key internal to be skipped")
+	}
+
+	backend, err := glib.MemorySettingsBackendNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings, err := glib.SettingsNewFull(schema, backend, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if width := settings.GetInt("window-width"); width != 800 {
+		t.Errorf("Expected window-width 800, got %d", width)
+	}
+	if theme := settings.GetString("theme"); theme != "system" {
+		t.Errorf("Expected theme %q, got %q", "system", theme)
+	}
+	if urls := settings.GetStrv("recent-urls"); !reflect.DeepEqual(urls, []string{"a", "b"}) {
+		t.Errorf("Expected recent-urls [a b], got %v", urls)
+	}
+	if settings.GetUserValue("window-width") != nil {
+		t.Error("Expected window-width to have no user value")
+	}
+
+	key := schema.GetKey("window-width")
+	if summary := key.GetSummary(); summary != "Width of the main window" {
+		t.Errorf("Expected summary %q, got %q", "Width of the main window", summary)
+	}
+	// The type outlives the key it belongs to.
+	valueType := schema.GetKey("theme").GetValueType()
+	runtime.GC()
+	if typ := valueType.String(); typ != "s" {
+		t.Errorf("Expected the type of theme to be %q, got %q", "s", typ)
+	}
+	tooSmall, err := glib.VariantInt32New(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.RangeCheck(tooSmall) {
+		t.Error("Expected 10 to be out of the range of window-width")
+	}
+
+	if !settings.SetInt("window-width", 1024) {
+		t.Fatal("Failed to set window-width")
+	}
+	if settings.GetUserValue("window-width") == nil {
+		t.Error("Expected window-width to have a user value")
+	}
+}
+
+func TestSettingsSchemaXML_Invalid(t *testing.T) {
+	_, err := glib.SettingsSchemaXML("", glib.SettingsSchemaDefinition{
+		ID: "org.gotk3.Invalid",
+		Keys: struct {
+			Size int32 `default:"large"`
+		}{},
+	})
+	if err == nil {
+		t.Error("Expected an error for an invalid default value")
+	}
+}
+
+func TestSettingsSchemaXML_Tags(t *testing.T) {
+	data, err := glib.SettingsSchemaXML("app", glib.SettingsSchemaDefinition{
+		ID: "org.gotk3.Tags",
+		Keys: struct {
+			Enabled bool   `default:"TRUE"`
+			Mode    string `choices:"fast, slow"`
+		}{Mode: "fast"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Tags are written out in the GVariant text format.
+	for _, expected := range []string{`gettext-domain="app"`, "<default>true</default>", `value="slow"`} {
+		if !bytes.Contains(data, []byte(expected)) {
+			t.Errorf("Expected %s in the schema:\n%s", expected, data)
+		}
+	}
+}
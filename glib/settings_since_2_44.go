@@ -0,0 +1,17 @@
+// +build !glib_2_40,!glib_2_42
+
+// See: https://developer.gnome.org/glib/2.44/api-index-2-44.html
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+
+// GetName is a wrapper around g_settings_schema_key_get_name().
+func (v *SettingsSchemaKey) GetName() string {
+	return goString(C.g_settings_schema_key_get_name(v.native()))
+}
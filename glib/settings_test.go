@@ -0,0 +1,176 @@
+package glib_test
+
+import (
+	"os/exec"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+// newTestSettings returns settings of the testPreferences schema,
+// stored by backend.
+func newTestSettings(t *testing.T, backend *glib.SettingsBackend) *glib.Settings {
+	t.Helper()
+	if _, err := exec.LookPath("glib-compile-schemas"); err != nil {
+		t.Skip("glib-compile-schemas not found")
+	}
+
+	def := glib.SettingsSchemaDefinition{
+		ID:   "org.gotk3.Test",
+		Path: "/org/gotk3/Test/",
+		Keys: testPreferences{WindowWidth: 800, Theme: "system"},
+	}
+	source, err := glib.SettingsSchemaSourceNewFromDefinitions(t.TempDir(), "", def)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings, err := glib.SettingsNewFull(source.Lookup(def.ID, false), backend, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return settings
+}
+
+// dispatchPending dispatches the sources of the default main context
+// which are ready, such as change notifications of settings.
+func dispatchPending() {
+	ctx := glib.MainContextDefault()
+	for ctx.Iteration(false) {
+	}
+}
+
+func TestSettingsBindings(t *testing.T) {
+	backend, err := glib.MemorySettingsBackendNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := newTestSettings(t, backend)
+
+	var changes []string
+	settings.ConnectChanged("show-hidden", func(key string) {
+		changes = append(changes, key)
+	})
+
+	// The action is enabled as long as hidden files are not shown.
+	action, err := glib.SimpleActionNew("hide", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings.BindWithMapping("show-hidden", action, "enabled", glib.SETTINGS_BIND_DEFAULT,
+		func(value *glib.Variant) (interface{}, bool) {
+			return !value.GetBoolean(), true
+		},
+		func(property interface{}, expectedType *glib.VariantType) *glib.Variant {
+			value, err := glib.VariantBooleanNew(!property.(bool))
+			if err != nil {
+				return nil
+			}
+			return value
+		})
+	if !action.GetEnabled() {
+		t.Error("Expected the action to be enabled by default")
+	}
+
+	toggle := settings.CreateAction("show-hidden")
+	// The action must keep its own reference.
+	runtime.GC()
+	runtime.GC()
+	toggle.Activate(nil)
+	dispatchPending()
+	if !settings.GetBoolean("show-hidden") || !toggle.GetState().GetBoolean() {
+		t.Error("Expected the settings action to toggle the key")
+	}
+	if action.GetEnabled() {
+		t.Error("Expected the bound action to be disabled")
+	}
+
+	action.SetEnabled(true)
+	dispatchPending()
+	if settings.GetBoolean("show-hidden") {
+		t.Error("Expected the bound property to reset the key")
+	}
+	if len(changes) != 2 || changes[0] != "show-hidden" {
+		t.Errorf("Expected two changes of show-hidden, got %v", changes)
+	}
+}
+
+// mapSettingsBackend stores settings in a map.
+type mapSettingsBackend struct {
+	sync.Mutex
+	values map[string]*glib.Variant
+}
+
+func (b *mapSettingsBackend) Read(key string, expectedType *glib.VariantType, defaultValue bool) *glib.Variant {
+	if defaultValue {
+		return nil
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.values[key]
+}
+
+func (b *mapSettingsBackend) Write(key string, value *glib.Variant) bool {
+	b.Lock()
+	defer b.Unlock()
+	b.values[key] = value
+	return true
+}
+
+func (b *mapSettingsBackend) Reset(key string) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.values, key)
+}
+
+func (b *mapSettingsBackend) GetWritable(key string) bool { return true }
+func (b *mapSettingsBackend) Subscribe(name string)       {}
+func (b *mapSettingsBackend) Unsubscribe(name string)     {}
+func (b *mapSettingsBackend) Sync()                       {}
+
+func TestSettingsBackendNew(t *testing.T) {
+	impl := &mapSettingsBackend{values: make(map[string]*glib.Variant)}
+	backend, err := glib.SettingsBackendNew(impl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := newTestSettings(t, backend)
+
+	var changes []string
+	settings.ConnectChanged("window-width", func(key string) {
+		changes = append(changes, key)
+	})
+
+	if width := settings.GetInt("window-width"); width != 800 {
+		t.Errorf("Expected the default width 800, got %d", width)
+	}
+	if !settings.SetInt("window-width", 1024) {
+		t.Fatal("Failed to set window-width")
+	}
+	dispatchPending()
+	impl.Lock()
+	stored := impl.values["/org/gotk3/Test/window-width"]
+	impl.Unlock()
+	if stored == nil {
+		t.Fatal("Expected the backend to store window-width")
+	}
+	if width, err := stored.GetInt(); err != nil || width != 1024 {
+		t.Errorf("Expected the backend to store 1024, got %d (%v)", width, err)
+	}
+
+	// Changes made to the storage are reported by the backend.
+	value, err := glib.VariantInt32New(640)
+	if err != nil {
+		t.Fatal(err)
+	}
+	impl.Write("/org/gotk3/Test/window-width", value)
+	backend.Changed("/org/gotk3/Test/window-width")
+	dispatchPending()
+	if width := settings.GetInt("window-width"); width != 640 {
+		t.Errorf("Expected the width 640, got %d", width)
+	}
+	if len(changes) != 2 {
+		t.Errorf("Expected two changes of window-width, got %v", changes)
+	}
+}
@@ -0,0 +1,678 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+// SocketFamily is a representation of GLib's GSocketFamily.
+type SocketFamily int
+
+const (
+	SOCKET_FAMILY_INVALID SocketFamily = C.G_SOCKET_FAMILY_INVALID
+	SOCKET_FAMILY_UNIX    SocketFamily = C.G_SOCKET_FAMILY_UNIX
+	SOCKET_FAMILY_IPV4    SocketFamily = C.G_SOCKET_FAMILY_IPV4
+	SOCKET_FAMILY_IPV6    SocketFamily = C.G_SOCKET_FAMILY_IPV6
+)
+
+// TLSCertificateFlags is a representation of GLib's GTlsCertificateFlags.
+type TLSCertificateFlags int
+
+const (
+	TLS_CERTIFICATE_UNKNOWN_CA    TLSCertificateFlags = C.G_TLS_CERTIFICATE_UNKNOWN_CA
+	TLS_CERTIFICATE_BAD_IDENTITY  TLSCertificateFlags = C.G_TLS_CERTIFICATE_BAD_IDENTITY
+	TLS_CERTIFICATE_NOT_ACTIVATED TLSCertificateFlags = C.G_TLS_CERTIFICATE_NOT_ACTIVATED
+	TLS_CERTIFICATE_EXPIRED       TLSCertificateFlags = C.G_TLS_CERTIFICATE_EXPIRED
+	TLS_CERTIFICATE_REVOKED       TLSCertificateFlags = C.G_TLS_CERTIFICATE_REVOKED
+	TLS_CERTIFICATE_INSECURE      TLSCertificateFlags = C.G_TLS_CERTIFICATE_INSECURE
+	TLS_CERTIFICATE_GENERIC_ERROR TLSCertificateFlags = C.G_TLS_CERTIFICATE_GENERIC_ERROR
+	TLS_CERTIFICATE_VALIDATE_ALL  TLSCertificateFlags = C.G_TLS_CERTIFICATE_VALIDATE_ALL
+)
+
+/*
+ * GInetAddress
+ */
+
+// InetAddress is a representation of GInetAddress.
+type InetAddress struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GInetAddress.
+func (v *InetAddress) native() *C.GInetAddress {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGInetAddress(ptr)
+}
+
+func marshalInetAddress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapInetAddress(obj), nil
+}
+
+func wrapInetAddress(obj *Object) *InetAddress {
+	return &InetAddress{obj}
+}
+
+// inetAddressFull wraps a GInetAddress returned with a full reference.
+func inetAddressFull(c *C.GInetAddress) *InetAddress {
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapInetAddress(obj)
+}
+
+// InetAddressNewFromString is a wrapper around g_inet_address_new_from_string().
+func InetAddressNewFromString(address string) (*InetAddress, error) {
+	cstr := C.CString(address)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_inet_address_new_from_string(cstr)
+	if c == nil {
+		return nil, errors.New("invalid IP address: " + address)
+	}
+	return inetAddressFull(c), nil
+}
+
+// InetAddressNewLoopback is a wrapper around g_inet_address_new_loopback().
+func InetAddressNewLoopback(family SocketFamily) *InetAddress {
+	return inetAddressFull(C.g_inet_address_new_loopback(C.GSocketFamily(family)))
+}
+
+// InetAddressNewAny is a wrapper around g_inet_address_new_any().
+func InetAddressNewAny(family SocketFamily) *InetAddress {
+	return inetAddressFull(C.g_inet_address_new_any(C.GSocketFamily(family)))
+}
+
+// ToString is a wrapper around g_inet_address_to_string().
+func (v *InetAddress) ToString() string {
+	c := C.g_inet_address_to_string(v.native())
+	defer C.g_free(C.gpointer(c))
+	return goString(c)
+}
+
+// GetFamily is a wrapper around g_inet_address_get_family().
+func (v *InetAddress) GetFamily() SocketFamily {
+	return SocketFamily(C.g_inet_address_get_family(v.native()))
+}
+
+// GetIsAny is a wrapper around g_inet_address_get_is_any().
+func (v *InetAddress) GetIsAny() bool {
+	return gobool(C.g_inet_address_get_is_any(v.native()))
+}
+
+// GetIsLoopback is a wrapper around g_inet_address_get_is_loopback().
+func (v *InetAddress) GetIsLoopback() bool {
+	return gobool(C.g_inet_address_get_is_loopback(v.native()))
+}
+
+// Equal is a wrapper around g_inet_address_equal().
+func (v *InetAddress) Equal(other *InetAddress) bool {
+	return gobool(C.g_inet_address_equal(v.native(), other.native()))
+}
+
+/*
+ * GSocketAddress
+ */
+
+// ISocketAddress is an interface type implemented by all structs
+// embedding a SocketAddress.
+type ISocketAddress interface {
+	toSocketAddress() *C.GSocketAddress
+}
+
+// SocketAddress is a representation of GSocketAddress.
+type SocketAddress struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GSocketAddress.
+func (v *SocketAddress) native() *C.GSocketAddress {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSocketAddress(ptr)
+}
+
+func (v *SocketAddress) toSocketAddress() *C.GSocketAddress {
+	return v.native()
+}
+
+func marshalSocketAddress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketAddress(obj), nil
+}
+
+func wrapSocketAddress(obj *Object) *SocketAddress {
+	return &SocketAddress{obj}
+}
+
+// GetFamily is a wrapper around g_socket_address_get_family().
+func (v *SocketAddress) GetFamily() SocketFamily {
+	return SocketFamily(C.g_socket_address_get_family(v.native()))
+}
+
+// ToInetSocketAddress returns the address as an InetSocketAddress,
+// or nil if it is not an internet address.
+func (v *SocketAddress) ToInetSocketAddress() *InetSocketAddress {
+	if !gobool(C.isGInetSocketAddress(unsafe.Pointer(v.native()))) {
+		return nil
+	}
+	return wrapInetSocketAddress(v.Object)
+}
+
+/*
+ * GInetSocketAddress
+ */
+
+// InetSocketAddress is a representation of GInetSocketAddress.
+type InetSocketAddress struct {
+	SocketAddress
+}
+
+// native() returns a pointer to the underlying GInetSocketAddress.
+func (v *InetSocketAddress) native() *C.GInetSocketAddress {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGInetSocketAddress(ptr)
+}
+
+func marshalInetSocketAddress(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapInetSocketAddress(obj), nil
+}
+
+func wrapInetSocketAddress(obj *Object) *InetSocketAddress {
+	return &InetSocketAddress{SocketAddress{obj}}
+}
+
+// InetSocketAddressNew is a wrapper around g_inet_socket_address_new().
+func InetSocketAddressNew(address *InetAddress, port uint16) *InetSocketAddress {
+	c := C.g_inet_socket_address_new(address.native(), C.guint16(port))
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapInetSocketAddress(obj)
+}
+
+// InetSocketAddressNewFromString is a wrapper around
+// g_inet_socket_address_new_from_string().
+func InetSocketAddressNewFromString(address string, port uint) (*InetSocketAddress, error) {
+	cstr := C.CString(address)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_inet_socket_address_new_from_string(cstr, C.guint(port))
+	if c == nil {
+		return nil, errors.New("invalid IP address: " + address)
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapInetSocketAddress(obj), nil
+}
+
+// GetAddress is a wrapper around g_inet_socket_address_get_address().
+func (v *InetSocketAddress) GetAddress() *InetAddress {
+	c := C.g_inet_socket_address_get_address(v.native())
+	return wrapInetAddress(Take(unsafe.Pointer(c)))
+}
+
+// GetPort is a wrapper around g_inet_socket_address_get_port().
+func (v *InetSocketAddress) GetPort() uint16 {
+	return uint16(C.g_inet_socket_address_get_port(v.native()))
+}
+
+/*
+ * GSocketConnection
+ */
+
+// SocketConnection is a representation of GSocketConnection. Its input
+// and output streams are those of the IOStream it embeds.
+type SocketConnection struct {
+	IOStream
+}
+
+// native() returns a pointer to the underlying GSocketConnection.
+func (v *SocketConnection) native() *C.GSocketConnection {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSocketConnection(ptr)
+}
+
+func marshalSocketConnection(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketConnection(obj), nil
+}
+
+func wrapSocketConnection(obj *Object) *SocketConnection {
+	return &SocketConnection{IOStream{obj}}
+}
+
+// socketConnectionFull wraps a GSocketConnection returned with
+// a full reference.
+func socketConnectionFull(c *C.GSocketConnection) *SocketConnection {
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSocketConnection(obj)
+}
+
+// IsConnected is a wrapper around g_socket_connection_is_connected().
+func (v *SocketConnection) IsConnected() bool {
+	return gobool(C.g_socket_connection_is_connected(v.native()))
+}
+
+// GetLocalAddress is a wrapper around g_socket_connection_get_local_address().
+func (v *SocketConnection) GetLocalAddress() (*SocketAddress, error) {
+	var err *C.GError
+	c := C.g_socket_connection_get_local_address(v.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSocketAddress(obj), nil
+}
+
+// GetRemoteAddress is a wrapper around g_socket_connection_get_remote_address().
+func (v *SocketConnection) GetRemoteAddress() (*SocketAddress, error) {
+	var err *C.GError
+	c := C.g_socket_connection_get_remote_address(v.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSocketAddress(obj), nil
+}
+
+/*
+ * GSocketClient
+ */
+
+// SocketClient is a representation of GSocketClient.
+type SocketClient struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GSocketClient.
+func (v *SocketClient) native() *C.GSocketClient {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSocketClient(ptr)
+}
+
+func marshalSocketClient(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketClient(obj), nil
+}
+
+func wrapSocketClient(obj *Object) *SocketClient {
+	return &SocketClient{obj}
+}
+
+// SocketClientNew is a wrapper around g_socket_client_new().
+func SocketClientNew() (*SocketClient, error) {
+	c := C.g_socket_client_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSocketClient(obj), nil
+}
+
+// GetTimeout is a wrapper around g_socket_client_get_timeout().
+func (v *SocketClient) GetTimeout() uint {
+	return uint(C.g_socket_client_get_timeout(v.native()))
+}
+
+// SetTimeout is a wrapper around g_socket_client_set_timeout().
+// The timeout is in seconds, 0 meaning no timeout.
+func (v *SocketClient) SetTimeout(timeout uint) {
+	C.g_socket_client_set_timeout(v.native(), C.guint(timeout))
+}
+
+// GetTLS is a wrapper around g_socket_client_get_tls().
+func (v *SocketClient) GetTLS() bool {
+	return gobool(C.g_socket_client_get_tls(v.native()))
+}
+
+// SetTLS is a wrapper around g_socket_client_set_tls().
+// Connections are then wrapped in TLS, which requires a TLS backend
+// such as glib-networking at runtime.
+func (v *SocketClient) SetTLS(tls bool) {
+	C.g_socket_client_set_tls(v.native(), gbool(tls))
+}
+
+// GetTLSValidationFlags is a wrapper around g_socket_client_get_tls_validation_flags().
+func (v *SocketClient) GetTLSValidationFlags() TLSCertificateFlags {
+	return TLSCertificateFlags(C.g_socket_client_get_tls_validation_flags(v.native()))
+}
+
+// SetTLSValidationFlags is a wrapper around g_socket_client_set_tls_validation_flags().
+func (v *SocketClient) SetTLSValidationFlags(flags TLSCertificateFlags) {
+	C.g_socket_client_set_tls_validation_flags(v.native(), C.GTlsCertificateFlags(flags))
+}
+
+// Connect is a wrapper around g_socket_client_connect().
+func (v *SocketClient) Connect(address ISocketAddress, cancel *Cancellable) (*SocketConnection, error) {
+	connectable := C.toGSocketConnectable(unsafe.Pointer(address.toSocketAddress()))
+
+	var err *C.GError
+	c := C.g_socket_client_connect(v.native(), connectable, cancel.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return socketConnectionFull(c), nil
+}
+
+// ConnectToHost is a wrapper around g_socket_client_connect_to_host().
+// hostAndPort is a host name or IP address, optionally followed by a
+// port, like "localhost:8080" or "[::1]:8080"; defaultPort is used when
+// it holds no port.
+func (v *SocketClient) ConnectToHost(hostAndPort string, defaultPort uint16,
+	cancel *Cancellable) (*SocketConnection, error) {
+	cstr := C.CString(hostAndPort)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_socket_client_connect_to_host(v.native(), cstr, C.guint16(defaultPort),
+		cancel.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return socketConnectionFull(c), nil
+}
+
+// ConnectToHostAsync is a wrapper around g_socket_client_connect_to_host_async().
+func (v *SocketClient) ConnectToHostAsync(hostAndPort string, defaultPort uint16,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(hostAndPort)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_socket_client_connect_to_host_async(v.native(), cstr, C.guint16(defaultPort),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// ConnectToHostFinish is a wrapper around g_socket_client_connect_to_host_finish().
+func (v *SocketClient) ConnectToHostFinish(result *AsyncResult) (*SocketConnection, error) {
+	var err *C.GError
+	c := C.g_socket_client_connect_to_host_finish(v.native(), result.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	return socketConnectionFull(c), nil
+}
+
+/*
+ * GSocketListener
+ */
+
+// SocketListener is a representation of GSocketListener.
+type SocketListener struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GSocketListener.
+func (v *SocketListener) native() *C.GSocketListener {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSocketListener(ptr)
+}
+
+func marshalSocketListener(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketListener(obj), nil
+}
+
+func wrapSocketListener(obj *Object) *SocketListener {
+	return &SocketListener{obj}
+}
+
+// sourceObject returns the GObject of source, which may be nil.
+func sourceObject(source IObject) *C.GObject {
+	if source == nil {
+		return nil
+	}
+	return source.toObject().native()
+}
+
+// AddInetPort is a wrapper around g_socket_listener_add_inet_port().
+// source may be nil; it is passed along with the connections accepted
+// on the port.
+func (v *SocketListener) AddInetPort(port uint16, source IObject) error {
+	var err *C.GError
+	c := C.g_socket_listener_add_inet_port(v.native(), C.guint16(port), sourceObject(source), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// AddAnyInetPort is a wrapper around g_socket_listener_add_any_inet_port().
+// The port chosen by the system is returned.
+func (v *SocketListener) AddAnyInetPort(source IObject) (uint16, error) {
+	var err *C.GError
+	c := C.g_socket_listener_add_any_inet_port(v.native(), sourceObject(source), &err)
+	if c == 0 {
+		return 0, takeError(err)
+	}
+	return uint16(c), nil
+}
+
+// Accept is a wrapper around g_socket_listener_accept().
+// The source object of the port the connection was accepted on is
+// returned along with the connection.
+func (v *SocketListener) Accept(cancel *Cancellable) (*SocketConnection, *Object, error) {
+	var source *C.GObject
+	var err *C.GError
+	c := C.g_socket_listener_accept(v.native(), &source, cancel.native(), &err)
+	if c == nil {
+		return nil, nil, takeError(err)
+	}
+	var obj *Object
+	if source != nil {
+		obj = Take(unsafe.Pointer(source))
+	}
+	return socketConnectionFull(c), obj, nil
+}
+
+// AcceptAsync is a wrapper around g_socket_listener_accept_async().
+func (v *SocketListener) AcceptAsync(cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_socket_listener_accept_async(v.native(), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// AcceptFinish is a wrapper around g_socket_listener_accept_finish().
+func (v *SocketListener) AcceptFinish(result *AsyncResult) (*SocketConnection, *Object, error) {
+	var source *C.GObject
+	var err *C.GError
+	c := C.g_socket_listener_accept_finish(v.native(), result.native(), &source, &err)
+	if c == nil {
+		return nil, nil, takeError(err)
+	}
+	var obj *Object
+	if source != nil {
+		obj = Take(unsafe.Pointer(source))
+	}
+	return socketConnectionFull(c), obj, nil
+}
+
+// Close is a wrapper around g_socket_listener_close().
+func (v *SocketListener) Close() {
+	C.g_socket_listener_close(v.native())
+}
+
+/*
+ * GSocketService
+ */
+
+// SocketService is a representation of GSocketService. Connections
+// are accepted by the main context the service was started from, which
+// emits the "incoming" signal for each of them.
+type SocketService struct {
+	SocketListener
+}
+
+// native() returns a pointer to the underlying GSocketService.
+func (v *SocketService) native() *C.GSocketService {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSocketService(ptr)
+}
+
+func marshalSocketService(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSocketService(obj), nil
+}
+
+func wrapSocketService(obj *Object) *SocketService {
+	return &SocketService{SocketListener{obj}}
+}
+
+// SocketServiceNew is a wrapper around g_socket_service_new().
+// The service is active once created.
+func SocketServiceNew() (*SocketService, error) {
+	c := C.g_socket_service_new()
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapSocketService(obj), nil
+}
+
+// Start is a wrapper around g_socket_service_start().
+func (v *SocketService) Start() {
+	C.g_socket_service_start(v.native())
+}
+
+// Stop is a wrapper around g_socket_service_stop().
+func (v *SocketService) Stop() {
+	C.g_socket_service_stop(v.native())
+}
+
+// IsActive is a wrapper around g_socket_service_is_active().
+func (v *SocketService) IsActive() bool {
+	return gobool(C.g_socket_service_is_active(v.native()))
+}
+
+// ConnectIncoming connects f to the "incoming" signal of the service,
+// which is emitted from the main loop for each accepted connection.
+// source is the object passed when adding the listening port, if any.
+// f returns true to stop other handlers from being called. Since f must
+// not block the main loop, the connection should be handled with
+// asynchronous operations; the connection is kept open as long as it
+// is referenced.
+func (v *SocketService) ConnectIncoming(f func(connection *SocketConnection,
+	source *Object) bool) (SignalHandle, error) {
+	// The instance may be a subclass of GSocketService.
+	return v.Connect("incoming", func(_ interface{}, connection *SocketConnection,
+		source *Object) bool {
+		return f(connection, source)
+	})
+}
+
+/*
+ * GThreadedSocketService
+ */
+
+// ThreadedSocketService is a representation of GThreadedSocketService.
+// Each accepted connection is handled by the "run" signal, which is
+// emitted from a worker thread, so blocking I/O is allowed there but
+// GTK+ must not be used.
+type ThreadedSocketService struct {
+	SocketService
+}
+
+// native() returns a pointer to the underlying GThreadedSocketService.
+func (v *ThreadedSocketService) native() *C.GThreadedSocketService {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGThreadedSocketService(ptr)
+}
+
+func marshalThreadedSocketService(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapThreadedSocketService(obj), nil
+}
+
+func wrapThreadedSocketService(obj *Object) *ThreadedSocketService {
+	return &ThreadedSocketService{SocketService{SocketListener{obj}}}
+}
+
+// ThreadedSocketServiceNew is a wrapper around g_threaded_socket_service_new().
+// maxThreads limits the number of connections handled at once, -1
+// meaning no limit.
+func ThreadedSocketServiceNew(maxThreads int) (*ThreadedSocketService, error) {
+	c := C.g_threaded_socket_service_new(C.int(maxThreads))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapThreadedSocketService(obj), nil
+}
+
+// ConnectRun connects f to the "run" signal of the service, which is
+// emitted from a worker thread for each accepted connection. The
+// connection is closed once f returns, unless it is still referenced.
+// f returns true to stop other handlers from being called.
+func (v *ThreadedSocketService) ConnectRun(f func(connection *SocketConnection,
+	source *Object) bool) (SignalHandle, error) {
+	return v.Connect("run", func(_ interface{}, connection *SocketConnection,
+		source *Object) bool {
+		return f(connection, source)
+	})
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_inet_address_get_type()), marshalInetAddress},
+		{Type(C.g_socket_address_get_type()), marshalSocketAddress},
+		{Type(C.g_inet_socket_address_get_type()), marshalInetSocketAddress},
+		{Type(C.g_socket_connection_get_type()), marshalSocketConnection},
+		{Type(C.g_socket_client_get_type()), marshalSocketClient},
+		{Type(C.g_socket_listener_get_type()), marshalSocketListener},
+		{Type(C.g_socket_service_get_type()), marshalSocketService},
+		{Type(C.g_threaded_socket_service_get_type()), marshalThreadedSocketService},
+	}
+	RegisterGValueMarshalers(tm)
+}
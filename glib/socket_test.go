@@ -0,0 +1,76 @@
+package glib_test
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+	"github.com/romychs/gotk3/gtk"
+)
+
+func TestSocketClient_ConnectToHost(t *testing.T) {
+	runtime.LockOSThread()
+
+	service, err := glib.ThreadedSocketServiceNew(-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer service.Stop()
+	port, err := service.AddAnyInetPort(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = service.ConnectRun(func(connection *glib.SocketConnection, _ *glib.Object) bool {
+		out, err := connection.GetOutputStream()
+		if err == nil {
+			out.WriteAll([]byte("hello"), nil)
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Connections are accepted by the main loop, so the client
+	// has to run from another goroutine.
+	var received string
+	var clientErr error
+	go func() {
+		defer glib.IdleAdd(gtk.MainQuit)
+
+		client, err := glib.SocketClientNew()
+		if err != nil {
+			clientErr = err
+			return
+		}
+		client.SetTimeout(5)
+		connection, err := client.ConnectToHost("127.0.0.1", port, nil)
+		if err != nil {
+			clientErr = err
+			return
+		}
+		defer connection.Close(nil)
+
+		in, err := connection.GetInputStream()
+		if err != nil {
+			clientErr = err
+			return
+		}
+		b := make([]byte, 5)
+		n, err := in.ReadAll(b, nil)
+		if err != nil {
+			clientErr = err
+			return
+		}
+		received = string(b[:n])
+	}()
+
+	gtk.Main()
+
+	if clientErr != nil {
+		t.Fatal(clientErr)
+	}
+	if received != "hello" {
+		t.Errorf("Expected to receive %q, got %q", "hello", received)
+	}
+}
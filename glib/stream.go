@@ -7,7 +7,6 @@ package glib
 // #include "glib.go.h"
 import "C"
 import (
-	"errors"
 	"runtime"
 	"unsafe"
 )
@@ -210,8 +209,7 @@ func (v *InputStream) Read(b []byte, cancel *Cancellable) (bytesRead int, e erro
 	c := C.g_input_stream_read(v.native(), unsafe.Pointer(&b[0]),
 		C.gsize(len(b)), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return int(c), errors.New(goString(err.message))
+		return int(c), takeError(err)
 	}
 	return int(c), nil
 }
@@ -223,8 +221,7 @@ func (v *InputStream) ReadAll(b []byte, cancel *Cancellable) (bytesRead int, e e
 	c := C.g_input_stream_read_all(v.native(), unsafe.Pointer(&b[0]),
 		C.gsize(len(b)), &br, cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return int(br), errors.New(goString(err.message))
+		return int(br), takeError(err)
 	}
 	return int(br), nil
 }
@@ -234,8 +231,7 @@ func (v *InputStream) Skip(count int, cancel *Cancellable) (bytesSkipped int, e
 	var err *C.GError
 	c := C.g_input_stream_skip(v.native(), C.gsize(count), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return 0, errors.New(goString(err.message))
+		return 0, takeError(err)
 	}
 	return int(c), nil
 }
@@ -245,8 +241,7 @@ func (v *InputStream) Close(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_input_stream_close(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -262,8 +257,7 @@ func (v *InputStream) ReadBytes(count int, cancel *Cancellable) (*Bytes, error)
 	var err *C.GError
 	c := C.g_input_stream_read_bytes(v.native(), C.gsize(count), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	b := newBytes(c)
 	return b, nil
@@ -360,8 +354,7 @@ func (v *FileInputStream) QueryInfo(attributes string, cancel *Cancellable) (*Fi
 	var err *C.GError
 	c := C.g_file_input_stream_query_info(v.native(), cstr, cancel.native(), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 
 	obj := Take(unsafe.Pointer(c))
@@ -411,8 +404,7 @@ func (v *OutputStream) Write(b []byte, cancel *Cancellable) (bytesWritten int, e
 	c := C.g_output_stream_write(v.native(), unsafe.Pointer(&b[0]),
 		C.gsize(len(b)), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return int(c), errors.New(goString(err.message))
+		return int(c), takeError(err)
 	}
 	return int(c), nil
 }
@@ -424,8 +416,7 @@ func (v *OutputStream) WriteAll(b []byte, cancel *Cancellable) (bytesWritten int
 	c := C.g_output_stream_write_all(v.native(), unsafe.Pointer(&b[0]),
 		C.gsize(len(b)), &bw, cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return int(bw), errors.New(goString(err.message))
+		return int(bw), takeError(err)
 	}
 	return int(bw), nil
 }
@@ -437,8 +428,7 @@ func (v *OutputStream) Splice(source *InputStream, flags OutputStreamSpliceFlags
 	c := C.g_output_stream_splice(v.native(), source.native(),
 		C.GOutputStreamSpliceFlags(flags), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return int(c), errors.New(goString(err.message))
+		return int(c), takeError(err)
 	}
 	return int(c), nil
 }
@@ -448,8 +438,7 @@ func (v *OutputStream) Flush(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_output_stream_flush(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -459,8 +448,7 @@ func (v *OutputStream) Close(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_output_stream_close(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -482,8 +470,7 @@ func (v *OutputStream) WriteBytes(bytes *Bytes, cancel *Cancellable) (int, error
 	var err *C.GError
 	c := C.g_output_stream_write_bytes(v.native(), bytes.native(), cancel.native(), &err)
 	if c == -1 {
-		defer C.g_error_free(err)
-		return -1, errors.New(goString(err.message))
+		return -1, takeError(err)
 	}
 	return int(c), nil
 }
@@ -580,8 +567,7 @@ func (v *FileOutputStream) QueryInfo(attributes string, cancel *Cancellable) (*F
 	var err *C.GError
 	c := C.g_file_output_stream_query_info(v.native(), cstr, cancel.native(), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 
 	obj := Take(unsafe.Pointer(c))
@@ -624,8 +610,7 @@ func (v *FileEnumerator) Iterate(cancel *Cancellable) (*FileInfo, *File, error)
 	var child *C.GFile
 	c := C.g_file_enumerator_iterate(v.native(), &info, &child, cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return nil, nil, errors.New(goString(err.message))
+		return nil, nil, takeError(err)
 	}
 	var info2 *FileInfo
 	var child2 *File
@@ -646,8 +631,7 @@ func (v *FileEnumerator) NextFile(cancel *Cancellable) (*FileInfo, error) {
 	var err *C.GError
 	c := C.g_file_enumerator_next_file(v.native(), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileInfo(obj), nil
@@ -658,8 +642,7 @@ func (v *FileEnumerator) Close(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_file_enumerator_close(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -750,8 +733,7 @@ func (v *IOStream) Close(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_io_stream_close(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -773,8 +755,7 @@ func (v *IOStream) SetPending() error {
 	var err *C.GError
 	c := C.g_io_stream_set_pending(v.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -836,8 +817,7 @@ func (v *Seekable) Seek(offset int64, seekType SeekType, cancel *Cancellable) er
 	c := C.g_seekable_seek(v.native(), C.goffset(offset),
 		C.GSeekType(seekType), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -854,8 +834,7 @@ func (v *Seekable) Truncate(offset int64, cancel *Cancellable) error {
 	c := C.g_seekable_truncate(v.native(), C.goffset(offset),
 		cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -964,6 +943,11 @@ func SetFinOnInterface(ptr unsafe.Pointer) *Interface {
 
 func marshalFile(p uintptr) (interface{}, error) {
 	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	if c == nil {
+		return (*File)(nil), nil
+	}
+	// The value keeps its own reference.
+	C.g_object_ref(C.gpointer(c))
 	intf := SetFinOnInterface(unsafe.Pointer(c))
 	return wrapFile(intf), nil
 }
@@ -1012,8 +996,7 @@ func (v *File) NewTmp(template string) (*File, *FileIOStream, error) {
 	var iostream *C.GFileIOStream
 	c := C.g_file_new_tmp(cstr, &iostream, &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, nil, errors.New(goString(err.message))
+		return nil, nil, takeError(err)
 	}
 
 	intf := SetFinOnInterface(unsafe.Pointer(c))
@@ -1118,8 +1101,7 @@ func (v *File) GetChildForDisplayName(displayName string) (*File, error) {
 	var err *C.GError
 	c := C.g_file_get_child_for_display_name(v.native(), cstr, &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 
 	intf := SetFinOnInterface(unsafe.Pointer(c))
@@ -1166,8 +1148,7 @@ func (v *File) Read(cancel *Cancellable) (*FileInputStream, error) {
 	var err *C.GError
 	c := C.g_file_read(v.native(), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileInputStream(obj), nil
@@ -1178,8 +1159,7 @@ func (v *File) AppendTo(flags FileCreateFlags, cancel *Cancellable) (*FileOutput
 	var err *C.GError
 	c := C.g_file_append_to(v.native(), C.GFileCreateFlags(flags), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileOutputStream(obj), nil
@@ -1190,8 +1170,7 @@ func (v *File) Create(flags FileCreateFlags, cancel *Cancellable) (*FileOutputSt
 	var err *C.GError
 	c := C.g_file_create(v.native(), C.GFileCreateFlags(flags), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileOutputStream(obj), nil
@@ -1205,8 +1184,7 @@ func (v *File) QueryInfo(attributes string, flags FileQueryInfoFlags, cancel *Ca
 	var err *C.GError
 	c := C.g_file_query_info(v.native(), cstr, C.GFileQueryInfoFlags(flags), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileInfo(obj), nil
@@ -1232,8 +1210,7 @@ func (v *File) QueryFileSystemInfo(attributes string, cancel *Cancellable) (*Fil
 	var err *C.GError
 	c := C.g_file_query_filesystem_info(v.native(), cstr, cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileInfo(obj), nil
@@ -1247,8 +1224,7 @@ func (v *File) EnumerateChildren(attributes string, flags FileQueryInfoFlags, ca
 	var err *C.GError
 	c := C.g_file_enumerate_children(v.native(), cstr, C.GFileQueryInfoFlags(flags), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileEnumerator(obj), nil
@@ -1262,8 +1238,7 @@ func (v *File) SetDisplayName(displayName string, cancel *Cancellable) (*File, e
 	var err *C.GError
 	c := C.g_file_set_display_name(v.native(), cstr, cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	intf := SetFinOnInterface(unsafe.Pointer(c))
 	return wrapFile(intf), nil
@@ -1274,8 +1249,7 @@ func (v *File) Delete(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_file_delete(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -1285,8 +1259,7 @@ func (v *File) Trash(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_file_trash(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -1296,8 +1269,7 @@ func (v *File) MakeDirectory(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_file_make_directory(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -1307,8 +1279,7 @@ func (v *File) MakeDirectoryWithParents(cancel *Cancellable) error {
 	var err *C.GError
 	c := C.g_file_make_directory_with_parents(v.native(), cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -1321,8 +1292,7 @@ func (v *File) MakeSymbolicLink(symlinkValue string, cancel *Cancellable) error
 	var err *C.GError
 	c := C.g_file_make_symbolic_link(v.native(), cstr, cancel.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return takeError(err)
 	}
 	return nil
 }
@@ -1332,8 +1302,7 @@ func (v *File) OpenReadWrite(cancel *Cancellable) (*FileIOStream, error) {
 	var err *C.GError
 	c := C.g_file_open_readwrite(v.native(), cancel.native(), &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, takeError(err)
 	}
 	obj := Take(unsafe.Pointer(c))
 	return wrapFileIOStream(obj), nil
@@ -1345,20 +1314,6 @@ func (v *File) OpenReadWrite(cancel *Cancellable) (*FileIOStream, error) {
 // char *	g_file_get_parse_name ()
 
 // GFile *	g_file_resolve_relative_path ()
-// void	g_file_read_async ()
-// GFileInputStream *	g_file_read_finish ()
-// GFileOutputStream *	g_file_replace ()
-// void	g_file_append_to_async ()
-// GFileOutputStream *	g_file_append_to_finish ()
-// void	g_file_create_async ()
-// GFileOutputStream *	g_file_create_finish ()
-// void	g_file_replace_async ()
-// GFileOutputStream *	g_file_replace_finish ()
-// void	g_file_query_info_async ()
-// GFileInfo *	g_file_query_info_finish ()
-// void	g_file_query_filesystem_info_async ()
-// GFileInfo *	g_file_query_filesystem_info_finish ()
-// GAppInfo *	g_file_query_default_handler ()
 // gboolean	g_file_measure_disk_usage ()
 // void	g_file_measure_disk_usage_async ()
 // gboolean	g_file_measure_disk_usage_finish ()
@@ -1366,32 +1321,9 @@ func (v *File) OpenReadWrite(cancel *Cancellable) (*FileIOStream, error) {
 // void	g_file_find_enclosing_mount_async ()
 // GMount *	g_file_find_enclosing_mount_finish ()
 
-// void	g_file_enumerate_children_async ()
-// GFileEnumerator *	g_file_enumerate_children_finish ()
-// void	g_file_set_display_name_async ()
-// GFile *	g_file_set_display_name_finish ()
-// void	g_file_delete_async ()
-// gboolean	g_file_delete_finish ()
-// void	g_file_trash_async ()
-// gboolean	g_file_trash_finish ()
-// gboolean	g_file_copy ()
-// void	g_file_copy_async ()
-// gboolean	g_file_copy_finish ()
-// gboolean	g_file_move ()
-// void	g_file_make_directory_async ()
-// gboolean	g_file_make_directory_finish ()
 // GFileAttributeInfoList *	g_file_query_settable_attributes ()
 // GFileAttributeInfoList *	g_file_query_writable_namespaces ()
 // gboolean	g_file_set_attribute ()
-// gboolean	g_file_set_attributes_from_info ()
-// void	g_file_set_attributes_async ()
-// gboolean	g_file_set_attributes_finish ()
-// gboolean	g_file_set_attribute_string ()
-// gboolean	g_file_set_attribute_byte_string ()
-// gboolean	g_file_set_attribute_uint32 ()
-// gboolean	g_file_set_attribute_int32 ()
-// gboolean	g_file_set_attribute_uint64 ()
-// gboolean	g_file_set_attribute_int64 ()
 // void	g_file_mount_mountable ()
 // GFile *	g_file_mount_mountable_finish ()
 // void	g_file_unmount_mountable ()
@@ -1410,24 +1342,12 @@ func (v *File) OpenReadWrite(cancel *Cancellable) (*FileIOStream, error) {
 // gboolean	g_file_poll_mountable_finish ()
 // void	g_file_mount_enclosing_volume ()
 // gboolean	g_file_mount_enclosing_volume_finish ()
-// GFileMonitor *	g_file_monitor_directory ()
-// GFileMonitor *	g_file_monitor_file ()
-// GFileMonitor *	g_file_monitor ()
-// gboolean	g_file_load_contents ()
-// void	g_file_load_contents_async ()
-// gboolean	g_file_load_contents_finish ()
 // void	g_file_load_partial_contents_async ()
 // gboolean	g_file_load_partial_contents_finish ()
-// gboolean	g_file_replace_contents ()
-// void	g_file_replace_contents_async ()
-// void	g_file_replace_contents_bytes_async ()
-// gboolean	g_file_replace_contents_finish ()
 // gboolean	g_file_copy_attributes ()
 // GFileIOStream *	g_file_create_readwrite ()
 // void	g_file_create_readwrite_async ()
 // GFileIOStream *	g_file_create_readwrite_finish ()
-// void	g_file_open_readwrite_async ()
-// GFileIOStream *	g_file_open_readwrite_finish ()
 // GFileIOStream *	g_file_replace_readwrite ()
 // void	g_file_replace_readwrite_async ()
 // GFileIOStream *	g_file_replace_readwrite_finish ()
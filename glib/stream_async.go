@@ -0,0 +1,475 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "async.go.h"
+import "C"
+import (
+	"unsafe"
+)
+
+// Asynchronous variants of the I/O operations defined in stream.go.
+//
+// Each operation is started by a method ending with Async, taking an I/O
+// priority (like PRIORITY_DEFAULT), an optional Cancellable and an
+// AsyncReadyCallback, which is invoked from the main context once the
+// operation completes. The callback passes its result to the method
+// ending with Finish to get the outcome of the operation.
+
+/*
+ * GInputStream
+ */
+
+// ReadBytesAsync is a wrapper around g_input_stream_read_bytes_async().
+// It is the asynchronous counterpart of both Read and ReadBytes, since
+// the data is returned with the result instead of being written to a
+// caller supplied buffer.
+func (v *InputStream) ReadBytesAsync(count int, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_input_stream_read_bytes_async(v.native(), C.gsize(count), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// ReadBytesFinish is a wrapper around g_input_stream_read_bytes_finish().
+func (v *InputStream) ReadBytesFinish(result *AsyncResult) (*Bytes, error) {
+	var err *C.GError
+	c := C.g_input_stream_read_bytes_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	return newBytes(c), nil
+}
+
+// SkipAsync is a wrapper around g_input_stream_skip_async().
+func (v *InputStream) SkipAsync(count int, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_input_stream_skip_async(v.native(), C.gsize(count), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// SkipFinish is a wrapper around g_input_stream_skip_finish().
+func (v *InputStream) SkipFinish(result *AsyncResult) (bytesSkipped int, e error) {
+	var err *C.GError
+	c := C.g_input_stream_skip_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int(c), nil
+}
+
+// CloseAsync is a wrapper around g_input_stream_close_async().
+func (v *InputStream) CloseAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_input_stream_close_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// CloseFinish is a wrapper around g_input_stream_close_finish().
+func (v *InputStream) CloseFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_input_stream_close_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * GFileInputStream
+ */
+
+// QueryInfoAsync is a wrapper around g_file_input_stream_query_info_async().
+func (v *FileInputStream) QueryInfoAsync(attributes string, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(attributes)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_input_stream_query_info_async(v.native(), cstr, C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// QueryInfoFinish is a wrapper around g_file_input_stream_query_info_finish().
+func (v *FileInputStream) QueryInfoFinish(result *AsyncResult) (*FileInfo, error) {
+	var err *C.GError
+	c := C.g_file_input_stream_query_info_finish(v.native(), result.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+/*
+ * GOutputStream
+ */
+
+// WriteBytesAsync is a wrapper around g_output_stream_write_bytes_async().
+// It is the asynchronous counterpart of both Write and WriteBytes.
+func (v *OutputStream) WriteBytesAsync(bytes *Bytes, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_output_stream_write_bytes_async(v.native(), bytes.native(), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// WriteBytesFinish is a wrapper around g_output_stream_write_bytes_finish().
+func (v *OutputStream) WriteBytesFinish(result *AsyncResult) (int, error) {
+	var err *C.GError
+	c := C.g_output_stream_write_bytes_finish(v.native(), result.native(), &err)
+	if c == -1 {
+		return -1, takeError(err)
+	}
+	return int(c), nil
+}
+
+// SpliceAsync is a wrapper around g_output_stream_splice_async().
+func (v *OutputStream) SpliceAsync(source *InputStream, flags OutputStreamSpliceFlags,
+	ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_output_stream_splice_async(v.native(), source.native(),
+		C.GOutputStreamSpliceFlags(flags), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// SpliceFinish is a wrapper around g_output_stream_splice_finish().
+func (v *OutputStream) SpliceFinish(result *AsyncResult) (dataSpliced int, e error) {
+	var err *C.GError
+	c := C.g_output_stream_splice_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return int(c), takeError(err)
+	}
+	return int(c), nil
+}
+
+// FlushAsync is a wrapper around g_output_stream_flush_async().
+func (v *OutputStream) FlushAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_output_stream_flush_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// FlushFinish is a wrapper around g_output_stream_flush_finish().
+func (v *OutputStream) FlushFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_output_stream_flush_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// CloseAsync is a wrapper around g_output_stream_close_async().
+func (v *OutputStream) CloseAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_output_stream_close_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// CloseFinish is a wrapper around g_output_stream_close_finish().
+func (v *OutputStream) CloseFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_output_stream_close_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * GFileOutputStream
+ */
+
+// QueryInfoAsync is a wrapper around g_file_output_stream_query_info_async().
+func (v *FileOutputStream) QueryInfoAsync(attributes string, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(attributes)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_output_stream_query_info_async(v.native(), cstr, C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// QueryInfoFinish is a wrapper around g_file_output_stream_query_info_finish().
+func (v *FileOutputStream) QueryInfoFinish(result *AsyncResult) (*FileInfo, error) {
+	var err *C.GError
+	c := C.g_file_output_stream_query_info_finish(v.native(), result.native(), &err)
+	if c == nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+/*
+ * GFileEnumerator
+ */
+
+// NextFilesAsync is a wrapper around g_file_enumerator_next_files_async().
+func (v *FileEnumerator) NextFilesAsync(numFiles int, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_enumerator_next_files_async(v.native(), C.int(numFiles), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// NextFilesFinish is a wrapper around g_file_enumerator_next_files_finish().
+// An empty slice is returned once the enumeration is complete.
+func (v *FileEnumerator) NextFilesFinish(result *AsyncResult) ([]*FileInfo, error) {
+	var err *C.GError
+	c := C.g_file_enumerator_next_files_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	// Both the list and its items are owned by the caller.
+	defer C.g_list_free_full(c, C.GDestroyNotify(C.g_object_unref))
+
+	var infos []*FileInfo
+	for l := c; l != nil; l = l.next {
+		obj := Take(unsafe.Pointer(l.data))
+		infos = append(infos, wrapFileInfo(obj))
+	}
+	return infos, nil
+}
+
+// CloseAsync is a wrapper around g_file_enumerator_close_async().
+func (v *FileEnumerator) CloseAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_enumerator_close_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// CloseFinish is a wrapper around g_file_enumerator_close_finish().
+func (v *FileEnumerator) CloseFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_file_enumerator_close_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * GIOStream
+ */
+
+// CloseAsync is a wrapper around g_io_stream_close_async().
+func (v *IOStream) CloseAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_io_stream_close_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// CloseFinish is a wrapper around g_io_stream_close_finish().
+func (v *IOStream) CloseFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_io_stream_close_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+/*
+ * GFile
+ */
+
+// void	g_file_read_async ()
+func (v *File) ReadAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_read_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// GFileInputStream *	g_file_read_finish ()
+func (v *File) ReadFinish(result *AsyncResult) (*FileInputStream, error) {
+	var err *C.GError
+	c := C.g_file_read_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInputStream(obj), nil
+}
+
+// void	g_file_append_to_async ()
+func (v *File) AppendToAsync(flags FileCreateFlags, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_file_append_to_async(v.native(), C.GFileCreateFlags(flags), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// GFileOutputStream *	g_file_append_to_finish ()
+func (v *File) AppendToFinish(result *AsyncResult) (*FileOutputStream, error) {
+	var err *C.GError
+	c := C.g_file_append_to_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileOutputStream(obj), nil
+}
+
+// void	g_file_create_async ()
+func (v *File) CreateAsync(flags FileCreateFlags, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_file_create_async(v.native(), C.GFileCreateFlags(flags), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// GFileOutputStream *	g_file_create_finish ()
+func (v *File) CreateFinish(result *AsyncResult) (*FileOutputStream, error) {
+	var err *C.GError
+	c := C.g_file_create_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileOutputStream(obj), nil
+}
+
+// void	g_file_query_info_async ()
+func (v *File) QueryInfoAsync(attributes string, flags FileQueryInfoFlags, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(attributes)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_query_info_async(v.native(), cstr, C.GFileQueryInfoFlags(flags),
+		C.int(ioPriority), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// GFileInfo *	g_file_query_info_finish ()
+func (v *File) QueryInfoFinish(result *AsyncResult) (*FileInfo, error) {
+	var err *C.GError
+	c := C.g_file_query_info_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+// void	g_file_query_filesystem_info_async ()
+func (v *File) QueryFileSystemInfoAsync(attributes string, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(attributes)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_query_filesystem_info_async(v.native(), cstr, C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// GFileInfo *	g_file_query_filesystem_info_finish ()
+func (v *File) QueryFileSystemInfoFinish(result *AsyncResult) (*FileInfo, error) {
+	var err *C.GError
+	c := C.g_file_query_filesystem_info_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileInfo(obj), nil
+}
+
+// void	g_file_enumerate_children_async ()
+func (v *File) EnumerateChildrenAsync(attributes string, flags FileQueryInfoFlags,
+	ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(attributes)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_enumerate_children_async(v.native(), cstr, C.GFileQueryInfoFlags(flags),
+		C.int(ioPriority), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// GFileEnumerator *	g_file_enumerate_children_finish ()
+func (v *File) EnumerateChildrenFinish(result *AsyncResult) (*FileEnumerator, error) {
+	var err *C.GError
+	c := C.g_file_enumerate_children_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileEnumerator(obj), nil
+}
+
+// void	g_file_set_display_name_async ()
+func (v *File) SetDisplayNameAsync(displayName string, ioPriority int,
+	cancel *Cancellable, callback AsyncReadyCallback) {
+	cstr := C.CString(displayName)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_file_set_display_name_async(v.native(), cstr, C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// GFile *	g_file_set_display_name_finish ()
+func (v *File) SetDisplayNameFinish(result *AsyncResult) (*File, error) {
+	var err *C.GError
+	c := C.g_file_set_display_name_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	intf := SetFinOnInterface(unsafe.Pointer(c))
+	return wrapFile(intf), nil
+}
+
+// void	g_file_delete_async ()
+func (v *File) DeleteAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_delete_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// gboolean	g_file_delete_finish ()
+func (v *File) DeleteFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_file_delete_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// void	g_file_trash_async ()
+func (v *File) TrashAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_trash_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// gboolean	g_file_trash_finish ()
+func (v *File) TrashFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_file_trash_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// void	g_file_make_directory_async ()
+func (v *File) MakeDirectoryAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_make_directory_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// gboolean	g_file_make_directory_finish ()
+func (v *File) MakeDirectoryFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_file_make_directory_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// void	g_file_open_readwrite_async ()
+func (v *File) OpenReadWriteAsync(ioPriority int, cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_file_open_readwrite_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// GFileIOStream *	g_file_open_readwrite_finish ()
+func (v *File) OpenReadWriteFinish(result *AsyncResult) (*FileIOStream, error) {
+	var err *C.GError
+	c := C.g_file_open_readwrite_finish(v.native(), result.native(), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapFileIOStream(obj), nil
+}
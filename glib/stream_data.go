@@ -0,0 +1,622 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "async.go.h"
+import "C"
+import (
+	"io"
+	"unsafe"
+)
+
+/*
+ * GBufferedInputStream
+ */
+
+// BufferedInputStream is a representation of GBufferedInputStream. It
+// reads its base stream by chunks, which lets the data be peeked at
+// before being read.
+type BufferedInputStream struct {
+	FilterInputStream
+}
+
+// native() returns a pointer to the underlying GBufferedInputStream.
+func (v *BufferedInputStream) native() *C.GBufferedInputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGBufferedInputStream(ptr)
+}
+
+func marshalBufferedInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapBufferedInputStream(obj), nil
+}
+
+func wrapBufferedInputStream(obj *Object) *BufferedInputStream {
+	return &BufferedInputStream{FilterInputStream{InputStream{obj}}}
+}
+
+// BufferedInputStreamNew is a wrapper around g_buffered_input_stream_new().
+func BufferedInputStreamNew(baseStream *InputStream) (*BufferedInputStream, error) {
+	c := C.g_buffered_input_stream_new(baseStream.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapBufferedInputStream(obj), nil
+}
+
+// BufferedInputStreamNewSized is a wrapper around
+// g_buffered_input_stream_new_sized().
+func BufferedInputStreamNewSized(baseStream *InputStream, size uint) (*BufferedInputStream, error) {
+	c := C.g_buffered_input_stream_new_sized(baseStream.native(), C.gsize(size))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapBufferedInputStream(obj), nil
+}
+
+// GetBufferSize is a wrapper around g_buffered_input_stream_get_buffer_size().
+func (v *BufferedInputStream) GetBufferSize() uint {
+	c := C.g_buffered_input_stream_get_buffer_size(v.native())
+	return uint(c)
+}
+
+// SetBufferSize is a wrapper around g_buffered_input_stream_set_buffer_size().
+// The buffer cannot be shrunk below the size of the data it holds.
+func (v *BufferedInputStream) SetBufferSize(size uint) {
+	C.g_buffered_input_stream_set_buffer_size(v.native(), C.gsize(size))
+}
+
+// GetAvailable is a wrapper around g_buffered_input_stream_get_available().
+// It returns the number of bytes which can be read without blocking.
+func (v *BufferedInputStream) GetAvailable() uint {
+	c := C.g_buffered_input_stream_get_available(v.native())
+	return uint(c)
+}
+
+// Peek is a wrapper around g_buffered_input_stream_peek(). It returns up
+// to count bytes of the buffer, starting at offset, without reading them.
+// Only the data already in the buffer is returned, see Fill.
+func (v *BufferedInputStream) Peek(offset, count uint) []byte {
+	if count == 0 {
+		return nil
+	}
+	b := make([]byte, count)
+	c := C.g_buffered_input_stream_peek(v.native(), unsafe.Pointer(&b[0]),
+		C.gsize(offset), C.gsize(count))
+	return b[:c]
+}
+
+// PeekBuffer is a wrapper around g_buffered_input_stream_peek_buffer(). It
+// returns a copy of the data of the buffer, without reading it.
+func (v *BufferedInputStream) PeekBuffer() []byte {
+	var count C.gsize
+	c := C.g_buffered_input_stream_peek_buffer(v.native(), &count)
+	return C.GoBytes(unsafe.Pointer(c), C.int(count))
+}
+
+// Fill is a wrapper around g_buffered_input_stream_fill(). It reads up
+// to count bytes from the base stream into the buffer, or as many as
+// the buffer can hold if count is -1. It returns the number of bytes
+// read, which is 0 at the end of the stream.
+func (v *BufferedInputStream) Fill(count int, cancel *Cancellable) (int, error) {
+	var err *C.GError
+	c := C.g_buffered_input_stream_fill(v.native(), C.gssize(count), cancel.native(), &err)
+	if c == -1 {
+		return -1, takeError(err)
+	}
+	return int(c), nil
+}
+
+// FillAsync is a wrapper around g_buffered_input_stream_fill_async().
+func (v *BufferedInputStream) FillAsync(count int, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_buffered_input_stream_fill_async(v.native(), C.gssize(count), C.int(ioPriority),
+		cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// FillFinish is a wrapper around g_buffered_input_stream_fill_finish().
+func (v *BufferedInputStream) FillFinish(result *AsyncResult) (int, error) {
+	var err *C.GError
+	c := C.g_buffered_input_stream_fill_finish(v.native(), result.native(), &err)
+	if c == -1 {
+		return -1, takeError(err)
+	}
+	return int(c), nil
+}
+
+// ReadByteCancellable is a wrapper around
+// g_buffered_input_stream_read_byte(). It returns io.EOF at the end of the
+// stream. It is also used by DataInputStream, whose
+// g_data_input_stream_read_byte() reports the end of the stream as an
+// error instead.
+func (v *BufferedInputStream) ReadByteCancellable(cancel *Cancellable) (byte, error) {
+	var err *C.GError
+	c := C.g_buffered_input_stream_read_byte(v.native(), cancel.native(), &err)
+	if c == -1 {
+		if err != nil {
+			return 0, takeError(err)
+		}
+		return 0, io.EOF
+	}
+	return byte(c), nil
+}
+
+/*
+ * GBufferedOutputStream
+ */
+
+// BufferedOutputStream is a representation of GBufferedOutputStream. It
+// writes to its base stream by chunks.
+type BufferedOutputStream struct {
+	FilterOutputStream
+}
+
+// native() returns a pointer to the underlying GBufferedOutputStream.
+func (v *BufferedOutputStream) native() *C.GBufferedOutputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGBufferedOutputStream(ptr)
+}
+
+func marshalBufferedOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapBufferedOutputStream(obj), nil
+}
+
+func wrapBufferedOutputStream(obj *Object) *BufferedOutputStream {
+	return &BufferedOutputStream{FilterOutputStream{OutputStream{obj}}}
+}
+
+// BufferedOutputStreamNew is a wrapper around g_buffered_output_stream_new().
+func BufferedOutputStreamNew(baseStream *OutputStream) (*BufferedOutputStream, error) {
+	c := C.g_buffered_output_stream_new(baseStream.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapBufferedOutputStream(obj), nil
+}
+
+// BufferedOutputStreamNewSized is a wrapper around
+// g_buffered_output_stream_new_sized().
+func BufferedOutputStreamNewSized(baseStream *OutputStream, size uint) (*BufferedOutputStream, error) {
+	c := C.g_buffered_output_stream_new_sized(baseStream.native(), C.gsize(size))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapBufferedOutputStream(obj), nil
+}
+
+// GetBufferSize is a wrapper around g_buffered_output_stream_get_buffer_size().
+func (v *BufferedOutputStream) GetBufferSize() uint {
+	c := C.g_buffered_output_stream_get_buffer_size(v.native())
+	return uint(c)
+}
+
+// SetBufferSize is a wrapper around g_buffered_output_stream_set_buffer_size().
+func (v *BufferedOutputStream) SetBufferSize(size uint) {
+	C.g_buffered_output_stream_set_buffer_size(v.native(), C.gsize(size))
+}
+
+// GetAutoGrow is a wrapper around g_buffered_output_stream_get_auto_grow().
+func (v *BufferedOutputStream) GetAutoGrow() bool {
+	c := C.g_buffered_output_stream_get_auto_grow(v.native())
+	return gobool(c)
+}
+
+// SetAutoGrow is a wrapper around g_buffered_output_stream_set_auto_grow().
+// When enabled, the buffer grows instead of being written to the base
+// stream once full.
+func (v *BufferedOutputStream) SetAutoGrow(autoGrow bool) {
+	C.g_buffered_output_stream_set_auto_grow(v.native(), gbool(autoGrow))
+}
+
+/*
+ * GDataStreamByteOrder and GDataStreamNewlineType
+ */
+
+// DataStreamByteOrder is a representation of GIO's GDataStreamByteOrder.
+type DataStreamByteOrder int
+
+const (
+	DATA_STREAM_BYTE_ORDER_BIG_ENDIAN    DataStreamByteOrder = C.G_DATA_STREAM_BYTE_ORDER_BIG_ENDIAN
+	DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN DataStreamByteOrder = C.G_DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN
+	DATA_STREAM_BYTE_ORDER_HOST_ENDIAN   DataStreamByteOrder = C.G_DATA_STREAM_BYTE_ORDER_HOST_ENDIAN
+)
+
+// DataStreamNewlineType is a representation of GIO's GDataStreamNewlineType.
+type DataStreamNewlineType int
+
+const (
+	DATA_STREAM_NEWLINE_TYPE_LF    DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_LF
+	DATA_STREAM_NEWLINE_TYPE_CR    DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_CR
+	DATA_STREAM_NEWLINE_TYPE_CR_LF DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_CR_LF
+	DATA_STREAM_NEWLINE_TYPE_ANY   DataStreamNewlineType = C.G_DATA_STREAM_NEWLINE_TYPE_ANY
+)
+
+/*
+ * GDataInputStream
+ */
+
+// DataInputStream is a representation of GDataInputStream. It reads
+// lines and binary values from its base stream.
+type DataInputStream struct {
+	BufferedInputStream
+}
+
+// native() returns a pointer to the underlying GDataInputStream.
+func (v *DataInputStream) native() *C.GDataInputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGDataInputStream(ptr)
+}
+
+func marshalDataInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapDataInputStream(obj), nil
+}
+
+func wrapDataInputStream(obj *Object) *DataInputStream {
+	return &DataInputStream{BufferedInputStream{FilterInputStream{InputStream{obj}}}}
+}
+
+// DataInputStreamNew is a wrapper around g_data_input_stream_new().
+func DataInputStreamNew(baseStream *InputStream) (*DataInputStream, error) {
+	c := C.g_data_input_stream_new(baseStream.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapDataInputStream(obj), nil
+}
+
+// GetByteOrder is a wrapper around g_data_input_stream_get_byte_order().
+func (v *DataInputStream) GetByteOrder() DataStreamByteOrder {
+	c := C.g_data_input_stream_get_byte_order(v.native())
+	return DataStreamByteOrder(c)
+}
+
+// SetByteOrder is a wrapper around g_data_input_stream_set_byte_order().
+// Values are read in big endian order by default.
+func (v *DataInputStream) SetByteOrder(order DataStreamByteOrder) {
+	C.g_data_input_stream_set_byte_order(v.native(), C.GDataStreamByteOrder(order))
+}
+
+// GetNewlineType is a wrapper around g_data_input_stream_get_newline_type().
+func (v *DataInputStream) GetNewlineType() DataStreamNewlineType {
+	c := C.g_data_input_stream_get_newline_type(v.native())
+	return DataStreamNewlineType(c)
+}
+
+// SetNewlineType is a wrapper around g_data_input_stream_set_newline_type().
+// Lines end with a line feed by default.
+func (v *DataInputStream) SetNewlineType(newlineType DataStreamNewlineType) {
+	C.g_data_input_stream_set_newline_type(v.native(), C.GDataStreamNewlineType(newlineType))
+}
+
+// ReadInt16 is a wrapper around g_data_input_stream_read_int16().
+func (v *DataInputStream) ReadInt16(cancel *Cancellable) (int16, error) {
+	var err *C.GError
+	c := C.g_data_input_stream_read_int16(v.native(), cancel.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int16(c), nil
+}
+
+// ReadUint16 is a wrapper around g_data_input_stream_read_uint16().
+func (v *DataInputStream) ReadUint16(cancel *Cancellable) (uint16, error) {
+	var err *C.GError
+	c := C.g_data_input_stream_read_uint16(v.native(), cancel.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return uint16(c), nil
+}
+
+// ReadInt32 is a wrapper around g_data_input_stream_read_int32().
+func (v *DataInputStream) ReadInt32(cancel *Cancellable) (int32, error) {
+	var err *C.GError
+	c := C.g_data_input_stream_read_int32(v.native(), cancel.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int32(c), nil
+}
+
+// ReadUint32 is a wrapper around g_data_input_stream_read_uint32().
+func (v *DataInputStream) ReadUint32(cancel *Cancellable) (uint32, error) {
+	var err *C.GError
+	c := C.g_data_input_stream_read_uint32(v.native(), cancel.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return uint32(c), nil
+}
+
+// ReadInt64 is a wrapper around g_data_input_stream_read_int64().
+func (v *DataInputStream) ReadInt64(cancel *Cancellable) (int64, error) {
+	var err *C.GError
+	c := C.g_data_input_stream_read_int64(v.native(), cancel.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return int64(c), nil
+}
+
+// ReadUint64 is a wrapper around g_data_input_stream_read_uint64().
+func (v *DataInputStream) ReadUint64(cancel *Cancellable) (uint64, error) {
+	var err *C.GError
+	c := C.g_data_input_stream_read_uint64(v.native(), cancel.native(), &err)
+	if err != nil {
+		return 0, takeError(err)
+	}
+	return uint64(c), nil
+}
+
+// goLine converts and frees a string returned by the line reading
+// functions, which return NULL without an error at the end of the stream.
+func goLine(c *C.char, length C.gsize, err *C.GError) (string, error) {
+	if c == nil {
+		if err != nil {
+			return "", takeError(err)
+		}
+		return "", io.EOF
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoStringN(c, C.int(length)), nil
+}
+
+// ReadLine is a wrapper around g_data_input_stream_read_line(). It returns
+// the next line, without its newline, or io.EOF at the end of the
+// stream. The line is not checked to be valid UTF-8.
+func (v *DataInputStream) ReadLine(cancel *Cancellable) (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_data_input_stream_read_line(v.native(), &length, cancel.native(), &err)
+	return goLine(c, length, err)
+}
+
+// ReadLineUTF8 is a wrapper around g_data_input_stream_read_line_utf8().
+// It is like ReadLine, but returns an error if the line is not valid
+// UTF-8.
+func (v *DataInputStream) ReadLineUTF8(cancel *Cancellable) (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_data_input_stream_read_line_utf8(v.native(), &length, cancel.native(), &err)
+	return goLine(c, length, err)
+}
+
+// ReadLineAsync is a wrapper around g_data_input_stream_read_line_async().
+func (v *DataInputStream) ReadLineAsync(ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	C.g_data_input_stream_read_line_async(v.native(), C.int(ioPriority), cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// ReadLineFinish is a wrapper around g_data_input_stream_read_line_finish().
+// It returns io.EOF at the end of the stream.
+func (v *DataInputStream) ReadLineFinish(result *AsyncResult) (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_data_input_stream_read_line_finish(v.native(), result.native(), &length, &err)
+	return goLine(c, length, err)
+}
+
+// ReadLineFinishUTF8 is a wrapper around
+// g_data_input_stream_read_line_finish_utf8(). It returns io.EOF at the
+// end of the stream.
+func (v *DataInputStream) ReadLineFinishUTF8(result *AsyncResult) (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_data_input_stream_read_line_finish_utf8(v.native(), result.native(), &length, &err)
+	return goLine(c, length, err)
+}
+
+// ReadUpto is a wrapper around g_data_input_stream_read_upto(). It returns
+// the data read until any of the bytes of stopChars, or io.EOF at the end
+// of the stream. The stop byte itself is not read, so it is returned
+// again by the next call unless it is consumed, with ReadByteCancellable.
+func (v *DataInputStream) ReadUpto(stopChars string, cancel *Cancellable) (string, error) {
+	cstr := C.CString(stopChars)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var length C.gsize
+	var err *C.GError
+	c := C.g_data_input_stream_read_upto(v.native(), (*C.gchar)(cstr), C.gssize(len(stopChars)),
+		&length, cancel.native(), &err)
+	return goLine(c, length, err)
+}
+
+// ReadUptoAsync is a wrapper around g_data_input_stream_read_upto_async().
+func (v *DataInputStream) ReadUptoAsync(stopChars string, ioPriority int, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	cstr := C.CString(stopChars)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_data_input_stream_read_upto_async(v.native(), (*C.gchar)(cstr), C.gssize(len(stopChars)),
+		C.int(ioPriority), cancel.native(), asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// ReadUptoFinish is a wrapper around g_data_input_stream_read_upto_finish().
+// It returns io.EOF at the end of the stream.
+func (v *DataInputStream) ReadUptoFinish(result *AsyncResult) (string, error) {
+	var length C.gsize
+	var err *C.GError
+	c := C.g_data_input_stream_read_upto_finish(v.native(), result.native(), &length, &err)
+	return goLine(c, length, err)
+}
+
+/*
+ * GDataOutputStream
+ */
+
+// DataOutputStream is a representation of GDataOutputStream. It writes
+// strings and binary values to its base stream.
+type DataOutputStream struct {
+	FilterOutputStream
+}
+
+// native() returns a pointer to the underlying GDataOutputStream.
+func (v *DataOutputStream) native() *C.GDataOutputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGDataOutputStream(ptr)
+}
+
+func marshalDataOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapDataOutputStream(obj), nil
+}
+
+func wrapDataOutputStream(obj *Object) *DataOutputStream {
+	return &DataOutputStream{FilterOutputStream{OutputStream{obj}}}
+}
+
+// DataOutputStreamNew is a wrapper around g_data_output_stream_new().
+func DataOutputStreamNew(baseStream *OutputStream) (*DataOutputStream, error) {
+	c := C.g_data_output_stream_new(baseStream.native())
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapDataOutputStream(obj), nil
+}
+
+// GetByteOrder is a wrapper around g_data_output_stream_get_byte_order().
+func (v *DataOutputStream) GetByteOrder() DataStreamByteOrder {
+	c := C.g_data_output_stream_get_byte_order(v.native())
+	return DataStreamByteOrder(c)
+}
+
+// SetByteOrder is a wrapper around g_data_output_stream_set_byte_order().
+// Values are written in big endian order by default.
+func (v *DataOutputStream) SetByteOrder(order DataStreamByteOrder) {
+	C.g_data_output_stream_set_byte_order(v.native(), C.GDataStreamByteOrder(order))
+}
+
+// PutByte is a wrapper around g_data_output_stream_put_byte().
+func (v *DataOutputStream) PutByte(data byte, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_byte(v.native(), C.guchar(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutInt16 is a wrapper around g_data_output_stream_put_int16().
+func (v *DataOutputStream) PutInt16(data int16, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_int16(v.native(), C.gint16(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutUint16 is a wrapper around g_data_output_stream_put_uint16().
+func (v *DataOutputStream) PutUint16(data uint16, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_uint16(v.native(), C.guint16(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutInt32 is a wrapper around g_data_output_stream_put_int32().
+func (v *DataOutputStream) PutInt32(data int32, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_int32(v.native(), C.gint32(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutUint32 is a wrapper around g_data_output_stream_put_uint32().
+func (v *DataOutputStream) PutUint32(data uint32, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_uint32(v.native(), C.guint32(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutInt64 is a wrapper around g_data_output_stream_put_int64().
+func (v *DataOutputStream) PutInt64(data int64, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_int64(v.native(), C.gint64(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutUint64 is a wrapper around g_data_output_stream_put_uint64().
+func (v *DataOutputStream) PutUint64(data uint64, cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_data_output_stream_put_uint64(v.native(), C.guint64(data), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// PutString is a wrapper around g_data_output_stream_put_string().
+func (v *DataOutputStream) PutString(str string, cancel *Cancellable) error {
+	cstr := C.CString(str)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var err *C.GError
+	c := C.g_data_output_stream_put_string(v.native(), cstr, cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_buffered_input_stream_get_type()), marshalBufferedInputStream},
+		{Type(C.g_buffered_output_stream_get_type()), marshalBufferedOutputStream},
+		{Type(C.g_data_input_stream_get_type()), marshalDataInputStream},
+		{Type(C.g_data_output_stream_get_type()), marshalDataOutputStream},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,78 @@
+package glib_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestDataStreams(t *testing.T) {
+	out, err := glib.MemoryOutputStreamResizableNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataOut, err := glib.DataOutputStreamNew(&out.OutputStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataOut.SetByteOrder(glib.DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN)
+	if err := dataOut.PutUint32(0xdeadbeef, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := dataOut.PutInt16(-2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := dataOut.PutString("key=value\r\nlast line", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := dataOut.Close(nil); err != nil {
+		t.Fatal(err)
+	}
+	bytes, err := out.StealAsBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := glib.MemoryInputStreamFromBytesNew(bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataIn, err := glib.DataInputStreamNew(&in.InputStream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataIn.SetByteOrder(glib.DATA_STREAM_BYTE_ORDER_LITTLE_ENDIAN)
+	dataIn.SetNewlineType(glib.DATA_STREAM_NEWLINE_TYPE_CR_LF)
+
+	if n, err := dataIn.Fill(-1, nil); err != nil || n != bytes.GetSize() {
+		t.Fatalf("Expected to fill %d bytes, got %d (%v)", bytes.GetSize(), n, err)
+	}
+	if peek := dataIn.Peek(0, 4); string(peek) != "\xef\xbe\xad\xde" {
+		t.Errorf("Expected to peek at the first value, got %q", peek)
+	}
+
+	if v, err := dataIn.ReadUint32(nil); err != nil || v != 0xdeadbeef {
+		t.Errorf("Expected 0xdeadbeef, got %#x (%v)", v, err)
+	}
+	if v, err := dataIn.ReadInt16(nil); err != nil || v != -2 {
+		t.Errorf("Expected -2, got %d (%v)", v, err)
+	}
+	if s, err := dataIn.ReadUpto("=", nil); err != nil || s != "key" {
+		t.Errorf("Expected %q, got %q (%v)", "key", s, err)
+	}
+	if b, err := dataIn.ReadByteCancellable(nil); err != nil || b != '=' {
+		t.Errorf("Expected '=', got %q (%v)", b, err)
+	}
+	for _, expected := range []string{"value", "last line"} {
+		if line, err := dataIn.ReadLine(nil); err != nil || line != expected {
+			t.Errorf("Expected %q, got %q (%v)", expected, line, err)
+		}
+	}
+	if _, err := dataIn.ReadLine(nil); err != io.EOF {
+		t.Errorf("Expected io.EOF, got %v", err)
+	}
+	if _, err := dataIn.ReadByteCancellable(nil); err != io.EOF {
+		t.Errorf("Expected io.EOF, got %v", err)
+	}
+}
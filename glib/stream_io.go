@@ -0,0 +1,441 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "stream_io.go.h"
+import "C"
+import (
+	"errors"
+	"io"
+	"sync"
+	"unsafe"
+)
+
+// The types of this file bridge GIO streams and the io package of Go, in
+// both directions. InputStreamReader and OutputStreamWriter let Go code
+// use GIO streams, while InputStreamFromReaderNew and
+// OutputStreamFromWriterNew let GIO consumers, such as
+// gdk.PixbufNewFromStream, use Go readers and writers.
+
+// seekable returns the Seekable interface of the stream object obj, or
+// nil if the stream does not implement it.
+func seekable(obj *Object) *Seekable {
+	if obj == nil || !gobool(C.isGSeekable(unsafe.Pointer(obj.Native()))) {
+		return nil
+	}
+	return wrapSeekable(*InterfaceFromObjectNew(obj))
+}
+
+// seekTo seeks s as io.Seeker.Seek does.
+func seekTo(s *Seekable, offset int64, whence int, cancel *Cancellable) (int64, error) {
+	if s == nil || !s.CanSeek() {
+		return 0, errors.New("stream is not seekable")
+	}
+
+	var seekType SeekType
+	switch whence {
+	case io.SeekStart:
+		seekType = SEEK_SET
+	case io.SeekCurrent:
+		seekType = SEEK_CUR
+	case io.SeekEnd:
+		seekType = SEEK_END
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if err := s.Seek(offset, seekType, cancel); err != nil {
+		return 0, err
+	}
+	return s.Tell(), nil
+}
+
+/*
+ * InputStreamReader
+ */
+
+// InputStreamReader reads from an InputStream as an io.ReadCloser. It is
+// also an io.Seeker, which succeeds if the stream is seekable, like the
+// streams of files are.
+type InputStreamReader struct {
+	stream *InputStream
+	cancel *Cancellable
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var (
+	_ io.ReadCloser = &InputStreamReader{}
+	_ io.Seeker     = &InputStreamReader{}
+)
+
+// InputStreamReaderNew returns an InputStreamReader reading from stream.
+// cancel, which may be nil, is passed to every operation on the stream.
+func InputStreamReaderNew(stream *InputStream, cancel *Cancellable) *InputStreamReader {
+	return &InputStreamReader{stream: stream, cancel: cancel}
+}
+
+// Read reads from the stream, blocking until some data is available. It
+// returns io.EOF at the end of the stream.
+func (v *InputStreamReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	n, err := v.stream.Read(p, v.cancel)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// Close closes the stream.
+func (v *InputStreamReader) Close() error {
+	return v.stream.Close(v.cancel)
+}
+
+// Seek seeks the stream, if it is seekable.
+func (v *InputStreamReader) Seek(offset int64, whence int) (int64, error) {
+	return seekTo(seekable(v.stream.Object), offset, whence, v.cancel)
+}
+
+/*
+ * OutputStreamWriter
+ */
+
+// OutputStreamWriter writes to an OutputStream as an io.WriteCloser. It is
+// also an io.Seeker, which succeeds if the stream is seekable.
+type OutputStreamWriter struct {
+	stream *OutputStream
+	cancel *Cancellable
+}
+
+// Static cast to verify at compile time that type on the right side
+// implement corresponding interface on the left.
+var (
+	_ io.WriteCloser = &OutputStreamWriter{}
+	_ io.Seeker      = &OutputStreamWriter{}
+)
+
+// OutputStreamWriterNew returns an OutputStreamWriter writing to stream.
+// cancel, which may be nil, is passed to every operation on the stream.
+func OutputStreamWriterNew(stream *OutputStream, cancel *Cancellable) *OutputStreamWriter {
+	return &OutputStreamWriter{stream: stream, cancel: cancel}
+}
+
+// Write writes all of p to the stream, or returns an error.
+func (v *OutputStreamWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	return v.stream.WriteAll(p, v.cancel)
+}
+
+// Flush flushes the stream.
+func (v *OutputStreamWriter) Flush() error {
+	return v.stream.Flush(v.cancel)
+}
+
+// Close closes the stream, flushing it first.
+func (v *OutputStreamWriter) Close() error {
+	return v.stream.Close(v.cancel)
+}
+
+// Seek seeks the stream, if it is seekable.
+func (v *OutputStreamWriter) Seek(offset int64, whence int) (int64, error) {
+	return seekTo(seekable(v.stream.Object), offset, whence, v.cancel)
+}
+
+/*
+ * Gotk3InputStream and Gotk3OutputStream
+ */
+
+var (
+	streamIORegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]interface{}
+	}{
+		next: 1,
+		m:    make(map[int]interface{}),
+	}
+)
+
+func registerStreamIO(rw interface{}) C.gpointer {
+	streamIORegistry.Lock()
+	defer streamIORegistry.Unlock()
+
+	id := streamIORegistry.next
+	streamIORegistry.next++
+	streamIORegistry.m[id] = rw
+	return C.gpointer(uintptr(id))
+}
+
+func streamIO(id C.gpointer) interface{} {
+	streamIORegistry.RLock()
+	defer streamIORegistry.RUnlock()
+
+	return streamIORegistry.m[int(uintptr(id))]
+}
+
+func unregisterStreamIO(id C.gpointer) {
+	streamIORegistry.Lock()
+	delete(streamIORegistry.m, int(uintptr(id)))
+	streamIORegistry.Unlock()
+}
+
+// setGError sets *gerr to the GError equivalent of err.
+func setGError(gerr **C.GError, err error) {
+	if gerr != nil {
+		*gerr = newGError(err)
+	}
+}
+
+// InputStreamFromReaderNew creates an InputStream reading from r, to be
+// passed to GIO consumers, such as gdk.PixbufNewFromStream. The stream
+// closes r if it is an io.Closer, and is seekable if r is an io.Seeker.
+// r is called from the thread doing the operation, which may not be the
+// main thread for asynchronous operations.
+func InputStreamFromReaderNew(r io.Reader) (*InputStream, error) {
+	id := registerStreamIO(r)
+	c := C._gotk3_input_stream_new(id)
+	if c == nil {
+		unregisterStreamIO(id)
+		return nil, errNilPtr
+	}
+
+	obj := wrapObject(unsafe.Pointer(c))
+	// The stream is created with a full reference,
+	// which is released once it is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapInputStream(obj), nil
+}
+
+// OutputStreamFromWriterNew creates an OutputStream writing to w, to be
+// passed to GIO consumers. The stream flushes w if it has a
+// Flush() error method, like bufio.Writer, and closes w if it is an
+// io.Closer. w is called from the thread doing the operation, which may
+// not be the main thread for asynchronous operations.
+func OutputStreamFromWriterNew(w io.Writer) (*OutputStream, error) {
+	id := registerStreamIO(&streamWriter{w: w})
+	c := C._gotk3_output_stream_new(id)
+	if c == nil {
+		unregisterStreamIO(id)
+		return nil, errNilPtr
+	}
+
+	obj := wrapObject(unsafe.Pointer(c))
+	// The stream is created with a full reference,
+	// which is released once it is wrapped.
+	C.g_object_unref(C.gpointer(c))
+	return wrapOutputStream(obj), nil
+}
+
+// maxBufferSize bounds the size of the buffers handed over by GIO, to
+// convert them to Go slices.
+const maxBufferSize = 1 << 30
+
+// goBuffer returns the count bytes at buffer as a slice, bounded by
+// maxBufferSize.
+func goBuffer(buffer unsafe.Pointer, count C.gsize) []byte {
+	if count > maxBufferSize {
+		count = maxBufferSize
+	}
+	return (*[maxBufferSize]byte)(buffer)[:count:count]
+}
+
+// maxEmptyReads is the number of reads returning no data and no error
+// after which a reader is deemed broken, since GIO takes a read returning
+// no data for the end of the stream.
+const maxEmptyReads = 100
+
+//export goInputStreamRead
+func goInputStreamRead(id C.gpointer, buffer unsafe.Pointer, count C.gsize,
+	gerr **C.GError) C.gssize {
+	r, ok := streamIO(id).(io.Reader)
+	if !ok {
+		setGError(gerr, ErrIOClosed)
+		return -1
+	}
+
+	p := goBuffer(buffer, count)
+	for i := 0; i < maxEmptyReads; i++ {
+		n, err := r.Read(p)
+		if n > 0 {
+			// An error returned along with data is returned
+			// again by the next read.
+			return C.gssize(n)
+		}
+		if err == io.EOF {
+			return 0
+		}
+		if err != nil {
+			setGError(gerr, err)
+			return -1
+		}
+	}
+	setGError(gerr, io.ErrNoProgress)
+	return -1
+}
+
+//export goInputStreamClose
+func goInputStreamClose(id C.gpointer, gerr **C.GError) C.gboolean {
+	if c, ok := streamIO(id).(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			setGError(gerr, err)
+			return C.FALSE
+		}
+	}
+	return C.TRUE
+}
+
+//export goInputStreamCanSeek
+func goInputStreamCanSeek(id C.gpointer) C.gboolean {
+	_, ok := streamIO(id).(io.Seeker)
+	return gbool(ok)
+}
+
+//export goInputStreamTell
+func goInputStreamTell(id C.gpointer) C.goffset {
+	s, ok := streamIO(id).(io.Seeker)
+	if !ok {
+		return 0
+	}
+	offset, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0
+	}
+	return C.goffset(offset)
+}
+
+//export goInputStreamSeek
+func goInputStreamSeek(id C.gpointer, offset C.goffset, seekType C.GSeekType,
+	gerr **C.GError) C.gboolean {
+	s, ok := streamIO(id).(io.Seeker)
+	if !ok {
+		setGError(gerr, ErrIONotSupported)
+		return C.FALSE
+	}
+
+	var whence int
+	switch SeekType(seekType) {
+	case SEEK_SET:
+		whence = io.SeekStart
+	case SEEK_CUR:
+		whence = io.SeekCurrent
+	case SEEK_END:
+		whence = io.SeekEnd
+	default:
+		setGError(gerr, ErrIOInvalidArgument)
+		return C.FALSE
+	}
+	if _, err := s.Seek(int64(offset), whence); err != nil {
+		setGError(gerr, err)
+		return C.FALSE
+	}
+	return C.TRUE
+}
+
+//export goInputStreamFinalize
+func goInputStreamFinalize(id C.gpointer) {
+	unregisterStreamIO(id)
+}
+
+// streamWriter is the writer of an OutputStream created by
+// OutputStreamFromWriterNew. It keeps the error of a write which still
+// wrote some bytes, since GIO would take an error for no bytes written,
+// and reports it on the next call instead. GIO does not run two
+// operations on a stream at once, so it needs no lock.
+type streamWriter struct {
+	w   io.Writer
+	err error
+}
+
+// streamWriterFor returns the writer of the stream id, or nil once the
+// stream is finalized.
+func streamWriterFor(id C.gpointer) *streamWriter {
+	w, _ := streamIO(id).(*streamWriter)
+	return w
+}
+
+// takeErr returns and clears the error left by the previous write.
+func (v *streamWriter) takeErr() error {
+	err := v.err
+	v.err = nil
+	return err
+}
+
+//export goOutputStreamWrite
+func goOutputStreamWrite(id C.gpointer, buffer unsafe.Pointer, count C.gsize,
+	gerr **C.GError) C.gssize {
+	w := streamWriterFor(id)
+	if w == nil {
+		setGError(gerr, ErrIOClosed)
+		return -1
+	}
+	if err := w.takeErr(); err != nil {
+		setGError(gerr, err)
+		return -1
+	}
+
+	n, err := w.w.Write(goBuffer(buffer, count))
+	if n > 0 {
+		w.err = err
+		return C.gssize(n)
+	}
+	if err != nil {
+		setGError(gerr, err)
+		return -1
+	}
+	return 0
+}
+
+//export goOutputStreamFlush
+func goOutputStreamFlush(id C.gpointer, gerr **C.GError) C.gboolean {
+	w := streamWriterFor(id)
+	if w == nil {
+		return C.TRUE
+	}
+	if err := w.takeErr(); err != nil {
+		setGError(gerr, err)
+		return C.FALSE
+	}
+	if f, ok := w.w.(interface{ Flush() error }); ok {
+		if err := f.Flush(); err != nil {
+			setGError(gerr, err)
+			return C.FALSE
+		}
+	}
+	return C.TRUE
+}
+
+//export goOutputStreamClose
+func goOutputStreamClose(id C.gpointer, gerr **C.GError) C.gboolean {
+	w := streamWriterFor(id)
+	if w == nil {
+		return C.TRUE
+	}
+	// The writer is closed even if a previous write failed.
+	err := w.takeErr()
+	if c, ok := w.w.(io.Closer); ok {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	if err != nil {
+		setGError(gerr, err)
+		return C.FALSE
+	}
+	return C.TRUE
+}
+
+//export goOutputStreamFinalize
+func goOutputStreamFinalize(id C.gpointer) {
+	unregisterStreamIO(id)
+}
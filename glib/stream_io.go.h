@@ -0,0 +1,245 @@
+// Same copyright and license as the rest of the files in this project
+
+// GInputStream and GOutputStream implemented in Go
+// See: https://developer.gnome.org/gio/stable/GInputStream.html
+
+#ifndef __GSTREAM_IO_GO_H__
+#define __GSTREAM_IO_GO_H__
+
+#include <gio/gio.h>
+
+extern gssize goInputStreamRead(gpointer id, void *buffer, gsize count,
+                                GError **error);
+extern gboolean goInputStreamClose(gpointer id, GError **error);
+extern gboolean goInputStreamCanSeek(gpointer id);
+extern goffset goInputStreamTell(gpointer id);
+extern gboolean goInputStreamSeek(gpointer id, goffset offset, GSeekType type,
+                                  GError **error);
+extern void goInputStreamFinalize(gpointer id);
+
+extern gssize goOutputStreamWrite(gpointer id, void *buffer, gsize count,
+                                  GError **error);
+extern gboolean goOutputStreamFlush(gpointer id, GError **error);
+extern gboolean goOutputStreamClose(gpointer id, GError **error);
+extern void goOutputStreamFinalize(gpointer id);
+
+/*
+ * Gotk3InputStream is a GInputStream reading from a Go io.Reader, which
+ * is referenced by id from the Go side. It is seekable if the reader is
+ * an io.Seeker.
+ */
+
+typedef struct {
+	GInputStream parent_instance;
+	gpointer id;
+} Gotk3InputStream;
+
+typedef struct {
+	GInputStreamClass parent_class;
+} Gotk3InputStreamClass;
+
+static GObjectClass *gotk3_input_stream_parent_class = NULL;
+
+#define GOTK3_INPUT_STREAM_ID(stream) \
+	(((Gotk3InputStream *)(stream))->id)
+
+static gssize
+gotk3_input_stream_read(GInputStream *stream, void *buffer, gsize count,
+                        GCancellable *cancellable, GError **error)
+{
+	return (goInputStreamRead(GOTK3_INPUT_STREAM_ID(stream), buffer, count,
+	    error));
+}
+
+static gboolean
+gotk3_input_stream_close(GInputStream *stream, GCancellable *cancellable,
+                         GError **error)
+{
+	return (goInputStreamClose(GOTK3_INPUT_STREAM_ID(stream), error));
+}
+
+static goffset
+gotk3_input_stream_tell(GSeekable *seekable)
+{
+	return (goInputStreamTell(GOTK3_INPUT_STREAM_ID(seekable)));
+}
+
+static gboolean
+gotk3_input_stream_can_seek(GSeekable *seekable)
+{
+	return (goInputStreamCanSeek(GOTK3_INPUT_STREAM_ID(seekable)));
+}
+
+static gboolean
+gotk3_input_stream_seek(GSeekable *seekable, goffset offset, GSeekType type,
+                        GCancellable *cancellable, GError **error)
+{
+	return (goInputStreamSeek(GOTK3_INPUT_STREAM_ID(seekable), offset, type,
+	    error));
+}
+
+static gboolean
+gotk3_input_stream_can_truncate(GSeekable *seekable)
+{
+	return (FALSE);
+}
+
+static gboolean
+gotk3_input_stream_truncate(GSeekable *seekable, goffset offset,
+                            GCancellable *cancellable, GError **error)
+{
+	g_set_error_literal(error, G_IO_ERROR, G_IO_ERROR_NOT_SUPPORTED,
+	    "Cannot truncate an input stream");
+	return (FALSE);
+}
+
+static void
+gotk3_input_stream_finalize(GObject *object)
+{
+	goInputStreamFinalize(GOTK3_INPUT_STREAM_ID(object));
+	gotk3_input_stream_parent_class->finalize(object);
+}
+
+static void
+gotk3_input_stream_class_init(gpointer g_class, gpointer class_data)
+{
+	GInputStreamClass *stream_class = g_class;
+
+	gotk3_input_stream_parent_class = g_type_class_peek_parent(g_class);
+	G_OBJECT_CLASS(g_class)->finalize = gotk3_input_stream_finalize;
+
+	stream_class->read_fn = gotk3_input_stream_read;
+	stream_class->close_fn = gotk3_input_stream_close;
+}
+
+static void
+gotk3_input_stream_seekable_init(gpointer g_iface, gpointer iface_data)
+{
+	GSeekableIface *iface = g_iface;
+
+	iface->tell = gotk3_input_stream_tell;
+	iface->can_seek = gotk3_input_stream_can_seek;
+	iface->seek = gotk3_input_stream_seek;
+	iface->can_truncate = gotk3_input_stream_can_truncate;
+	iface->truncate_fn = gotk3_input_stream_truncate;
+}
+
+static GType
+gotk3_input_stream_get_type(void)
+{
+	static gsize type_id = 0;
+
+	if (g_once_init_enter(&type_id)) {
+		const GInterfaceInfo seekable_info = {
+			gotk3_input_stream_seekable_init, NULL, NULL
+		};
+		GType t = g_type_register_static_simple(G_TYPE_INPUT_STREAM,
+			g_intern_static_string("Gotk3InputStream"),
+			sizeof(Gotk3InputStreamClass),
+			gotk3_input_stream_class_init,
+			sizeof(Gotk3InputStream), NULL, 0);
+		g_type_add_interface_static(t, G_TYPE_SEEKABLE, &seekable_info);
+		g_once_init_leave(&type_id, t);
+	}
+	return (type_id);
+}
+
+static GInputStream *
+_gotk3_input_stream_new(gpointer id)
+{
+	Gotk3InputStream *self = g_object_new(gotk3_input_stream_get_type(),
+	    NULL);
+
+	self->id = id;
+	return (G_INPUT_STREAM(self));
+}
+
+/*
+ * Gotk3OutputStream is a GOutputStream writing to a Go io.Writer, which
+ * is referenced by id from the Go side.
+ */
+
+typedef struct {
+	GOutputStream parent_instance;
+	gpointer id;
+} Gotk3OutputStream;
+
+typedef struct {
+	GOutputStreamClass parent_class;
+} Gotk3OutputStreamClass;
+
+static GObjectClass *gotk3_output_stream_parent_class = NULL;
+
+#define GOTK3_OUTPUT_STREAM_ID(stream) \
+	(((Gotk3OutputStream *)(stream))->id)
+
+static gssize
+gotk3_output_stream_write(GOutputStream *stream, const void *buffer,
+                          gsize count, GCancellable *cancellable,
+                          GError **error)
+{
+	return (goOutputStreamWrite(GOTK3_OUTPUT_STREAM_ID(stream),
+	    (void *)buffer, count, error));
+}
+
+static gboolean
+gotk3_output_stream_flush(GOutputStream *stream, GCancellable *cancellable,
+                          GError **error)
+{
+	return (goOutputStreamFlush(GOTK3_OUTPUT_STREAM_ID(stream), error));
+}
+
+static gboolean
+gotk3_output_stream_close(GOutputStream *stream, GCancellable *cancellable,
+                          GError **error)
+{
+	return (goOutputStreamClose(GOTK3_OUTPUT_STREAM_ID(stream), error));
+}
+
+static void
+gotk3_output_stream_finalize(GObject *object)
+{
+	goOutputStreamFinalize(GOTK3_OUTPUT_STREAM_ID(object));
+	gotk3_output_stream_parent_class->finalize(object);
+}
+
+static void
+gotk3_output_stream_class_init(gpointer g_class, gpointer class_data)
+{
+	GOutputStreamClass *stream_class = g_class;
+
+	gotk3_output_stream_parent_class = g_type_class_peek_parent(g_class);
+	G_OBJECT_CLASS(g_class)->finalize = gotk3_output_stream_finalize;
+
+	stream_class->write_fn = gotk3_output_stream_write;
+	stream_class->flush = gotk3_output_stream_flush;
+	stream_class->close_fn = gotk3_output_stream_close;
+}
+
+static GType
+gotk3_output_stream_get_type(void)
+{
+	static gsize type_id = 0;
+
+	if (g_once_init_enter(&type_id)) {
+		GType t = g_type_register_static_simple(G_TYPE_OUTPUT_STREAM,
+			g_intern_static_string("Gotk3OutputStream"),
+			sizeof(Gotk3OutputStreamClass),
+			gotk3_output_stream_class_init,
+			sizeof(Gotk3OutputStream), NULL, 0);
+		g_once_init_leave(&type_id, t);
+	}
+	return (type_id);
+}
+
+static GOutputStream *
+_gotk3_output_stream_new(gpointer id)
+{
+	Gotk3OutputStream *self = g_object_new(gotk3_output_stream_get_type(),
+	    NULL);
+
+	self->id = id;
+	return (G_OUTPUT_STREAM(self));
+}
+
+#endif
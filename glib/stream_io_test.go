@@ -0,0 +1,104 @@
+package glib_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestInputStreamFromReaderNew(t *testing.T) {
+	data := []byte("0123456789abcdef")
+	stream, err := glib.InputStreamFromReaderNew(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := glib.InputStreamReaderNew(stream, nil)
+	defer r.Close()
+
+	b := make([]byte, 4)
+	if _, err := io.ReadFull(r, b); err != nil || string(b) != "0123" {
+		t.Fatalf("Expected %q, got %q (%v)", "0123", b, err)
+	}
+
+	// The stream is seekable, since bytes.Reader is an io.Seeker.
+	if offset, err := r.Seek(-6, io.SeekEnd); err != nil || offset != 10 {
+		t.Fatalf("Expected offset 10, got %d (%v)", offset, err)
+	}
+	if rest, err := io.ReadAll(r); err != nil || string(rest) != "abcdef" {
+		t.Errorf("Expected %q, got %q (%v)", "abcdef", rest, err)
+	}
+}
+
+type closeBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closeBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestOutputStreamFromWriterNew(t *testing.T) {
+	var buf closeBuffer
+	stream, err := glib.OutputStreamFromWriterNew(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := glib.OutputStreamWriterNew(stream, nil)
+	if _, err := io.WriteString(w, "hello, "); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.WriteAll([]byte("world"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.String() != "hello, world" {
+		t.Errorf("Expected %q, got %q", "hello, world", buf.String())
+	}
+	if !buf.closed {
+		t.Error("Expected the writer to be closed along with the stream")
+	}
+	if _, err := w.Seek(0, io.SeekStart); err == nil {
+		t.Error("Expected an error seeking a stream which is not seekable")
+	}
+}
+
+// shortWriter fails the writes going past its limit, after writing the
+// bytes fitting in it.
+type shortWriter struct {
+	bytes.Buffer
+	limit int
+}
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if n := w.limit - w.Len(); len(p) > n {
+		w.Buffer.Write(p[:n])
+		return n, io.ErrShortWrite
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestOutputStreamFromWriterNew_ShortWrite(t *testing.T) {
+	buf := shortWriter{limit: 3}
+	stream, err := glib.OutputStreamFromWriterNew(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The bytes written are reported first, and the error next.
+	if n, err := stream.Write([]byte("hello"), nil); n != 3 || err != nil {
+		t.Errorf("Expected 3 bytes written without error, got %d (%v)", n, err)
+	}
+	if _, err := stream.Write([]byte("lo"), nil); err == nil || err.Error() != io.ErrShortWrite.Error() {
+		t.Errorf("Expected the short write error, got %v", err)
+	}
+	if buf.String() != "hel" {
+		t.Errorf("Expected %q, got %q", "hel", buf.String())
+	}
+}
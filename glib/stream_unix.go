@@ -0,0 +1,211 @@
+// +build !windows
+
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: gio-2.0 gio-unix-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+// #include "stream_unix.go.h"
+import "C"
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// dupFile duplicates the file descriptor of f, with the close-on-exec
+// flag set. The duplicate refers to the same open file, but has its own
+// lifetime, so that it can be owned by GLib while f is still owned by Go.
+// Unlike f.Fd(), it leaves f in non-blocking mode.
+func dupFile(f *os.File) (int, error) {
+	raw, err := f.SyscallConn()
+	if err != nil {
+		return -1, err
+	}
+
+	fd := -1
+	var dupErr error
+	err = raw.Control(func(sysfd uintptr) {
+		// The lock keeps the duplicate from leaking to child processes
+		// forked before the flag is set.
+		syscall.ForkLock.RLock()
+		defer syscall.ForkLock.RUnlock()
+		fd, dupErr = syscall.Dup(int(sysfd))
+		if dupErr == nil {
+			syscall.CloseOnExec(fd)
+		}
+	})
+	if err != nil {
+		return -1, err
+	}
+	if dupErr != nil {
+		return -1, os.NewSyscallError("dup", dupErr)
+	}
+	return fd, nil
+}
+
+/*
+ * GUnixInputStream
+ */
+
+// UnixInputStream is a representation of GUnixInputStream, reading from
+// a UNIX file descriptor, such as a pipe or a socket.
+type UnixInputStream struct {
+	InputStream
+}
+
+// native() returns a pointer to the underlying GUnixInputStream.
+func (v *UnixInputStream) native() *C.GUnixInputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGUnixInputStream(ptr)
+}
+
+func marshalUnixInputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapUnixInputStream(obj), nil
+}
+
+func wrapUnixInputStream(obj *Object) *UnixInputStream {
+	return &UnixInputStream{InputStream{obj}}
+}
+
+// UnixInputStreamNew is a wrapper around g_unix_input_stream_new(). If
+// closeFd is true, fd is owned by the stream, which closes it when it is
+// closed or finalized; it must not be closed by other means then.
+func UnixInputStreamNew(fd int, closeFd bool) (*UnixInputStream, error) {
+	c := C.g_unix_input_stream_new(C.gint(fd), gbool(closeFd))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapUnixInputStream(obj), nil
+}
+
+// UnixInputStreamFromFileNew creates a UnixInputStream reading from f. The
+// stream owns a duplicate of the file descriptor of f, so f and the
+// stream are closed independently of each other, and f may be closed as
+// soon as this function returns.
+func UnixInputStreamFromFileNew(f *os.File) (*UnixInputStream, error) {
+	fd, err := dupFile(f)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := UnixInputStreamNew(fd, true)
+	if err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+	return stream, nil
+}
+
+// GetFd is a wrapper around g_unix_input_stream_get_fd().
+func (v *UnixInputStream) GetFd() int {
+	c := C.g_unix_input_stream_get_fd(v.native())
+	return int(c)
+}
+
+// GetCloseFd is a wrapper around g_unix_input_stream_get_close_fd().
+func (v *UnixInputStream) GetCloseFd() bool {
+	c := C.g_unix_input_stream_get_close_fd(v.native())
+	return gobool(c)
+}
+
+// SetCloseFd is a wrapper around g_unix_input_stream_set_close_fd().
+func (v *UnixInputStream) SetCloseFd(closeFd bool) {
+	C.g_unix_input_stream_set_close_fd(v.native(), gbool(closeFd))
+}
+
+/*
+ * GUnixOutputStream
+ */
+
+// UnixOutputStream is a representation of GUnixOutputStream, writing to
+// a UNIX file descriptor, such as a pipe or a socket.
+type UnixOutputStream struct {
+	OutputStream
+}
+
+// native() returns a pointer to the underlying GUnixOutputStream.
+func (v *UnixOutputStream) native() *C.GUnixOutputStream {
+	if v == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGUnixOutputStream(ptr)
+}
+
+func marshalUnixOutputStream(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapUnixOutputStream(obj), nil
+}
+
+func wrapUnixOutputStream(obj *Object) *UnixOutputStream {
+	return &UnixOutputStream{OutputStream{obj}}
+}
+
+// UnixOutputStreamNew is a wrapper around g_unix_output_stream_new(). If
+// closeFd is true, fd is owned by the stream, which closes it when it is
+// closed or finalized; it must not be closed by other means then.
+func UnixOutputStreamNew(fd int, closeFd bool) (*UnixOutputStream, error) {
+	c := C.g_unix_output_stream_new(C.gint(fd), gbool(closeFd))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := wrapObject(unsafe.Pointer(c))
+	// wrapObject() takes its own reference.
+	C.g_object_unref(C.gpointer(c))
+	return wrapUnixOutputStream(obj), nil
+}
+
+// UnixOutputStreamFromFileNew creates a UnixOutputStream writing to f. The
+// stream owns a duplicate of the file descriptor of f, so f and the
+// stream are closed independently of each other. The reader of a pipe
+// only gets to its end once both are closed.
+func UnixOutputStreamFromFileNew(f *os.File) (*UnixOutputStream, error) {
+	fd, err := dupFile(f)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := UnixOutputStreamNew(fd, true)
+	if err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+	return stream, nil
+}
+
+// GetFd is a wrapper around g_unix_output_stream_get_fd().
+func (v *UnixOutputStream) GetFd() int {
+	c := C.g_unix_output_stream_get_fd(v.native())
+	return int(c)
+}
+
+// GetCloseFd is a wrapper around g_unix_output_stream_get_close_fd().
+func (v *UnixOutputStream) GetCloseFd() bool {
+	c := C.g_unix_output_stream_get_close_fd(v.native())
+	return gobool(c)
+}
+
+// SetCloseFd is a wrapper around g_unix_output_stream_set_close_fd().
+func (v *UnixOutputStream) SetCloseFd(closeFd bool) {
+	C.g_unix_output_stream_set_close_fd(v.native(), gbool(closeFd))
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_unix_input_stream_get_type()), marshalUnixInputStream},
+		{Type(C.g_unix_output_stream_get_type()), marshalUnixOutputStream},
+	}
+	RegisterGValueMarshalers(tm)
+}
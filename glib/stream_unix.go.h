@@ -0,0 +1,25 @@
+// Same copyright and license as the rest of the files in this project
+
+// GUnixInputStream, GUnixOutputStream
+// See: https://developer.gnome.org/gio/stable/GUnixInputStream.html
+
+#ifndef __GSTREAM_UNIX_GO_H__
+#define __GSTREAM_UNIX_GO_H__
+
+#include <gio/gio.h>
+#include <gio/gunixinputstream.h>
+#include <gio/gunixoutputstream.h>
+
+static GUnixInputStream *
+toGUnixInputStream(void *p)
+{
+	return (G_UNIX_INPUT_STREAM(p));
+}
+
+static GUnixOutputStream *
+toGUnixOutputStream(void *p)
+{
+	return (G_UNIX_OUTPUT_STREAM(p));
+}
+
+#endif
@@ -0,0 +1,51 @@
+// +build !windows
+
+package glib_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestUnixStreamsFromFile(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	in, err := glib.UnixInputStreamFromFileNew(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := glib.UnixOutputStreamFromFileNew(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The streams own duplicates of the descriptors of the files,
+	// which stay open once the files are closed.
+	r.Close()
+	if _, err := w.WriteString("from Go, "); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+	if _, err := out.WriteAll([]byte("from GLib"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := out.Close(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := io.ReadAll(glib.InputStreamReaderNew(&in.InputStream, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "from Go, from GLib" {
+		t.Errorf("Expected %q, got %q", "from Go, from GLib", data)
+	}
+	if !in.GetCloseFd() {
+		t.Error("Expected the stream to own its descriptor")
+	}
+}
@@ -0,0 +1,506 @@
+// Same copyright and license as the rest of the files in this project
+
+package glib
+
+// #cgo pkg-config: glib-2.0 gobject-2.0 gio-2.0
+// #include <gio/gio.h>
+// #include <glib.h>
+// #include <glib-object.h>
+// #include "glib.go.h"
+import "C"
+import (
+	"errors"
+	"unsafe"
+)
+
+// SubprocessFlags is a representation of GLib's GSubprocessFlags.
+type SubprocessFlags int
+
+const (
+	SUBPROCESS_FLAGS_NONE           SubprocessFlags = C.G_SUBPROCESS_FLAGS_NONE
+	SUBPROCESS_FLAGS_STDIN_PIPE     SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDIN_PIPE
+	SUBPROCESS_FLAGS_STDIN_INHERIT  SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDIN_INHERIT
+	SUBPROCESS_FLAGS_STDOUT_PIPE    SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDOUT_PIPE
+	SUBPROCESS_FLAGS_STDOUT_SILENCE SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDOUT_SILENCE
+	SUBPROCESS_FLAGS_STDERR_PIPE    SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_PIPE
+	SUBPROCESS_FLAGS_STDERR_SILENCE SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_SILENCE
+	SUBPROCESS_FLAGS_STDERR_MERGE   SubprocessFlags = C.G_SUBPROCESS_FLAGS_STDERR_MERGE
+	SUBPROCESS_FLAGS_INHERIT_FDS    SubprocessFlags = C.G_SUBPROCESS_FLAGS_INHERIT_FDS
+)
+
+/*
+ * GSubprocess
+ */
+
+// Subprocess is a representation of GSubprocess.
+//
+// The asynchronous methods report the termination of the process from
+// the thread-default main context of the caller, so a GTK+ application
+// can follow a child process without blocking its main loop.
+type Subprocess struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GSubprocess.
+func (v *Subprocess) native() *C.GSubprocess {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSubprocess(ptr)
+}
+
+func marshalSubprocess(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocess(obj), nil
+}
+
+func wrapSubprocess(obj *Object) *Subprocess {
+	return &Subprocess{obj}
+}
+
+// SubprocessNew is a wrapper around g_subprocess_newv().
+// argv[0] is the program, looked up in PATH
+// if it does not contain a directory.
+func SubprocessNew(argv []string, flags SubprocessFlags) (*Subprocess, error) {
+	cargv := C.make_strings(C.int(len(argv) + 1))
+	defer C.destroy_strings(cargv)
+
+	for i, arg := range argv {
+		carg := C.CString(arg)
+		defer C.free(unsafe.Pointer(carg))
+		C.set_string(cargv, C.int(i), carg)
+	}
+	C.set_string(cargv, C.int(len(argv)), nil)
+
+	var err *C.GError
+	c := C.g_subprocess_newv(cargv, C.GSubprocessFlags(flags), &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocess(obj), nil
+}
+
+// GetIdentifier is a wrapper around g_subprocess_get_identifier().
+// On UNIX, this is the process ID. An empty string is returned once
+// the process has terminated.
+func (v *Subprocess) GetIdentifier() string {
+	c := C.g_subprocess_get_identifier(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
+// GetStdinPipe is a wrapper around g_subprocess_get_stdin_pipe().
+// nil is returned unless SUBPROCESS_FLAGS_STDIN_PIPE was used.
+func (v *Subprocess) GetStdinPipe() *OutputStream {
+	c := C.g_subprocess_get_stdin_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapOutputStream(obj)
+}
+
+// GetStdoutPipe is a wrapper around g_subprocess_get_stdout_pipe().
+// nil is returned unless SUBPROCESS_FLAGS_STDOUT_PIPE was used.
+func (v *Subprocess) GetStdoutPipe() *InputStream {
+	c := C.g_subprocess_get_stdout_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapInputStream(obj)
+}
+
+// GetStderrPipe is a wrapper around g_subprocess_get_stderr_pipe().
+// nil is returned unless SUBPROCESS_FLAGS_STDERR_PIPE was used.
+func (v *Subprocess) GetStderrPipe() *InputStream {
+	c := C.g_subprocess_get_stderr_pipe(v.native())
+	if c == nil {
+		return nil
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapInputStream(obj)
+}
+
+// Wait is a wrapper around g_subprocess_wait().
+func (v *Subprocess) Wait(cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_subprocess_wait(v.native(), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// WaitAsync is a wrapper around g_subprocess_wait_async().
+func (v *Subprocess) WaitAsync(cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_subprocess_wait_async(v.native(), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// WaitFinish is a wrapper around g_subprocess_wait_finish().
+func (v *Subprocess) WaitFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_subprocess_wait_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// WaitCheck is a wrapper around g_subprocess_wait_check().
+// Unlike Wait, an error is also returned if the process
+// did not exit successfully.
+func (v *Subprocess) WaitCheck(cancel *Cancellable) error {
+	var err *C.GError
+	c := C.g_subprocess_wait_check(v.native(), cancel.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// WaitCheckAsync is a wrapper around g_subprocess_wait_check_async().
+func (v *Subprocess) WaitCheckAsync(cancel *Cancellable, callback AsyncReadyCallback) {
+	C.g_subprocess_wait_check_async(v.native(), cancel.native(), asyncReadyCallback(),
+		registerAsyncReadyCallback(callback))
+}
+
+// WaitCheckFinish is a wrapper around g_subprocess_wait_check_finish().
+func (v *Subprocess) WaitCheckFinish(result *AsyncResult) error {
+	var err *C.GError
+	c := C.g_subprocess_wait_check_finish(v.native(), result.native(), &err)
+	if c == 0 {
+		return takeError(err)
+	}
+	return nil
+}
+
+// GetSuccessful is a wrapper around g_subprocess_get_successful().
+func (v *Subprocess) GetSuccessful() bool {
+	return gobool(C.g_subprocess_get_successful(v.native()))
+}
+
+// GetIfExited is a wrapper around g_subprocess_get_if_exited().
+func (v *Subprocess) GetIfExited() bool {
+	return gobool(C.g_subprocess_get_if_exited(v.native()))
+}
+
+// GetExitStatus is a wrapper around g_subprocess_get_exit_status().
+// It must only be called once the process exited normally.
+func (v *Subprocess) GetExitStatus() int {
+	return int(C.g_subprocess_get_exit_status(v.native()))
+}
+
+// GetIfSignaled is a wrapper around g_subprocess_get_if_signaled().
+func (v *Subprocess) GetIfSignaled() bool {
+	return gobool(C.g_subprocess_get_if_signaled(v.native()))
+}
+
+// GetTermSig is a wrapper around g_subprocess_get_term_sig().
+// It must only be called once the process was terminated by a signal.
+func (v *Subprocess) GetTermSig() int {
+	return int(C.g_subprocess_get_term_sig(v.native()))
+}
+
+// GetStatus is a wrapper around g_subprocess_get_status().
+func (v *Subprocess) GetStatus() int {
+	return int(C.g_subprocess_get_status(v.native()))
+}
+
+// SendSignal is a wrapper around g_subprocess_send_signal().
+// It does nothing once the process has terminated.
+func (v *Subprocess) SendSignal(signalNum int) {
+	C.g_subprocess_send_signal(v.native(), C.gint(signalNum))
+}
+
+// ForceExit is a wrapper around g_subprocess_force_exit().
+func (v *Subprocess) ForceExit() {
+	C.g_subprocess_force_exit(v.native())
+}
+
+// errNoStdinPipe is returned when input is given to a process started
+// without SUBPROCESS_FLAGS_STDIN_PIPE, which GLib rejects without
+// setting an error.
+var errNoStdinPipe = errors.New("subprocess was not started with SUBPROCESS_FLAGS_STDIN_PIPE")
+
+// communicateError returns the error of a failed communication.
+func communicateError(err *C.GError) error {
+	if err == nil {
+		// GLib rejects invalid arguments without setting an error.
+		return errors.New("subprocess communication failed")
+	}
+	return takeError(err)
+}
+
+// Communicate is a wrapper around g_subprocess_communicate().
+// stdinBuf is written to the stdin pipe, which is then closed;
+// stdout and stderr are collected if they are pipes. stdinBuf must
+// be nil unless SUBPROCESS_FLAGS_STDIN_PIPE was used.
+func (v *Subprocess) Communicate(stdinBuf []byte, cancel *Cancellable) ([]byte, []byte, error) {
+	if stdinBuf != nil && v.GetStdinPipe() == nil {
+		return nil, nil, errNoStdinPipe
+	}
+
+	var cstdin *C.GBytes
+	if stdinBuf != nil {
+		var data C.gconstpointer
+		if len(stdinBuf) > 0 {
+			data = C.gconstpointer(unsafe.Pointer(&stdinBuf[0]))
+		}
+		cstdin = C.g_bytes_new(data, C.gsize(len(stdinBuf)))
+		defer C.g_bytes_unref(cstdin)
+	}
+
+	var stdoutBuf, stderrBuf *C.GBytes
+	var err *C.GError
+	c := C.g_subprocess_communicate(v.native(), cstdin, cancel.native(),
+		&stdoutBuf, &stderrBuf, &err)
+	if c == 0 {
+		return nil, nil, communicateError(err)
+	}
+	return goBytesUnref(stdoutBuf), goBytesUnref(stderrBuf), nil
+}
+
+// CommunicateUtf8 is a wrapper around g_subprocess_communicate_utf8().
+// stdinBuf is written to the stdin pipe, which is then closed;
+// stdout and stderr are collected if they are pipes. stdinBuf must
+// be empty unless SUBPROCESS_FLAGS_STDIN_PIPE was used.
+func (v *Subprocess) CommunicateUtf8(stdinBuf string, cancel *Cancellable) (string, string, error) {
+	if stdinBuf != "" && v.GetStdinPipe() == nil {
+		return "", "", errNoStdinPipe
+	}
+	cstdin := cStdinString(stdinBuf)
+	defer C.free(unsafe.Pointer(cstdin))
+
+	var stdoutBuf, stderrBuf *C.char
+	var err *C.GError
+	c := C.g_subprocess_communicate_utf8(v.native(), cstdin, cancel.native(),
+		&stdoutBuf, &stderrBuf, &err)
+	if c == 0 {
+		return "", "", communicateError(err)
+	}
+	return goStringFree(stdoutBuf), goStringFree(stderrBuf), nil
+}
+
+// CommunicateUtf8Async is a wrapper around g_subprocess_communicate_utf8_async().
+// If stdinBuf is not empty while SUBPROCESS_FLAGS_STDIN_PIPE was not used,
+// the error is reported to the callback by CommunicateUtf8Finish.
+func (v *Subprocess) CommunicateUtf8Async(stdinBuf string, cancel *Cancellable,
+	callback AsyncReadyCallback) {
+	if stdinBuf != "" && v.GetStdinPipe() == nil {
+		// GLib would return without ever calling the callback.
+		task, err := TaskNew(v, cancel, callback)
+		if err == nil {
+			task.ReturnError(errNoStdinPipe)
+		}
+		return
+	}
+	// the buffer is copied before the call returns.
+	cstdin := cStdinString(stdinBuf)
+	defer C.free(unsafe.Pointer(cstdin))
+
+	C.g_subprocess_communicate_utf8_async(v.native(), cstdin, cancel.native(),
+		asyncReadyCallback(), registerAsyncReadyCallback(callback))
+}
+
+// CommunicateUtf8Finish is a wrapper around g_subprocess_communicate_utf8_finish().
+func (v *Subprocess) CommunicateUtf8Finish(result *AsyncResult) (string, string, error) {
+	var stdoutBuf, stderrBuf *C.char
+	var err *C.GError
+	c := C.g_subprocess_communicate_utf8_finish(v.native(), result.native(),
+		&stdoutBuf, &stderrBuf, &err)
+	if c == 0 {
+		return "", "", communicateError(err)
+	}
+	return goStringFree(stdoutBuf), goStringFree(stderrBuf), nil
+}
+
+// cStdinString returns stdinBuf as a C string, or NULL if it is empty,
+// as GLib only accepts input for processes with a stdin pipe.
+func cStdinString(stdinBuf string) *C.char {
+	if stdinBuf == "" {
+		return nil
+	}
+	return C.CString(stdinBuf)
+}
+
+// goStringFree returns the Go string of a C string which may be NULL,
+// and frees the C string.
+func goStringFree(c *C.char) string {
+	if c == nil {
+		return ""
+	}
+	defer C.g_free(C.gpointer(c))
+	return C.GoString(c)
+}
+
+// goBytesUnref returns the contents of a GBytes which may be NULL,
+// and releases the GBytes.
+func goBytesUnref(c *C.GBytes) []byte {
+	if c == nil {
+		return nil
+	}
+	defer C.g_bytes_unref(c)
+
+	var size C.gsize
+	data := C.g_bytes_get_data(c, &size)
+	return C.GoBytes(unsafe.Pointer(data), C.int(size))
+}
+
+/*
+ * GSubprocessLauncher
+ */
+
+// SubprocessLauncher is a representation of GSubprocessLauncher.
+type SubprocessLauncher struct {
+	*Object
+}
+
+// native() returns a pointer to the underlying GSubprocessLauncher.
+func (v *SubprocessLauncher) native() *C.GSubprocessLauncher {
+	if v == nil || v.Object == nil {
+		return nil
+	}
+	ptr := unsafe.Pointer(v.Object.Native())
+	return C.toGSubprocessLauncher(ptr)
+}
+
+func marshalSubprocessLauncher(p uintptr) (interface{}, error) {
+	c := C.g_value_get_object((*C.GValue)(unsafe.Pointer(p)))
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocessLauncher(obj), nil
+}
+
+func wrapSubprocessLauncher(obj *Object) *SubprocessLauncher {
+	return &SubprocessLauncher{obj}
+}
+
+// SubprocessLauncherNew is a wrapper around g_subprocess_launcher_new().
+func SubprocessLauncherNew(flags SubprocessFlags) (*SubprocessLauncher, error) {
+	c := C.g_subprocess_launcher_new(C.GSubprocessFlags(flags))
+	if c == nil {
+		return nil, errNilPtr
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocessLauncher(obj), nil
+}
+
+// Spawn is a wrapper around g_subprocess_launcher_spawnv().
+func (v *SubprocessLauncher) Spawn(argv []string) (*Subprocess, error) {
+	cargv := C.make_strings(C.int(len(argv) + 1))
+	defer C.destroy_strings(cargv)
+
+	for i, arg := range argv {
+		carg := C.CString(arg)
+		defer C.free(unsafe.Pointer(carg))
+		C.set_string(cargv, C.int(i), carg)
+	}
+	C.set_string(cargv, C.int(len(argv)), nil)
+
+	var err *C.GError
+	c := C.g_subprocess_launcher_spawnv(v.native(), cargv, &err)
+	if err != nil {
+		return nil, takeError(err)
+	}
+	obj := Take(unsafe.Pointer(c))
+	return wrapSubprocess(obj), nil
+}
+
+// SetFlags is a wrapper around g_subprocess_launcher_set_flags().
+func (v *SubprocessLauncher) SetFlags(flags SubprocessFlags) {
+	C.g_subprocess_launcher_set_flags(v.native(), C.GSubprocessFlags(flags))
+}
+
+// SetEnviron is a wrapper around g_subprocess_launcher_set_environ().
+// env holds "NAME=VALUE" strings, like os.Environ() returns, and
+// replaces the whole environment of the spawned processes.
+func (v *SubprocessLauncher) SetEnviron(env []string) {
+	cenv := C.make_strings(C.int(len(env) + 1))
+	defer C.destroy_strings(cenv)
+
+	for i, str := range env {
+		cstr := C.CString(str)
+		defer C.free(unsafe.Pointer(cstr))
+		C.set_string(cenv, C.int(i), cstr)
+	}
+	C.set_string(cenv, C.int(len(env)), nil)
+
+	C.g_subprocess_launcher_set_environ(v.native(), cenv)
+}
+
+// Setenv is a wrapper around g_subprocess_launcher_setenv().
+func (v *SubprocessLauncher) Setenv(variable, value string, overwrite bool) {
+	cstr1 := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr1))
+
+	cstr2 := C.CString(value)
+	defer C.free(unsafe.Pointer(cstr2))
+
+	C.g_subprocess_launcher_setenv(v.native(), cstr1, cstr2, gbool(overwrite))
+}
+
+// Unsetenv is a wrapper around g_subprocess_launcher_unsetenv().
+func (v *SubprocessLauncher) Unsetenv(variable string) {
+	cstr := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_unsetenv(v.native(), cstr)
+}
+
+// Getenv is a wrapper around g_subprocess_launcher_getenv().
+func (v *SubprocessLauncher) Getenv(variable string) (string, bool) {
+	cstr := C.CString(variable)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.g_subprocess_launcher_getenv(v.native(), cstr)
+	if c == nil {
+		return "", false
+	}
+	return goString(c), true
+}
+
+// SetCwd is a wrapper around g_subprocess_launcher_set_cwd().
+func (v *SubprocessLauncher) SetCwd(cwd string) {
+	cstr := C.CString(cwd)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_cwd(v.native(), cstr)
+}
+
+// SetStdinFilePath is a wrapper around g_subprocess_launcher_set_stdin_file_path().
+func (v *SubprocessLauncher) SetStdinFilePath(path string) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_stdin_file_path(v.native(), cstr)
+}
+
+// SetStdoutFilePath is a wrapper around g_subprocess_launcher_set_stdout_file_path().
+func (v *SubprocessLauncher) SetStdoutFilePath(path string) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_stdout_file_path(v.native(), cstr)
+}
+
+// SetStderrFilePath is a wrapper around g_subprocess_launcher_set_stderr_file_path().
+func (v *SubprocessLauncher) SetStderrFilePath(path string) {
+	cstr := C.CString(path)
+	defer C.free(unsafe.Pointer(cstr))
+
+	C.g_subprocess_launcher_set_stderr_file_path(v.native(), cstr)
+}
+
+func init() {
+	tm := []TypeMarshaler{
+		{Type(C.g_subprocess_get_type()), marshalSubprocess},
+		{Type(C.g_subprocess_launcher_get_type()), marshalSubprocessLauncher},
+	}
+	RegisterGValueMarshalers(tm)
+}
@@ -0,0 +1,45 @@
+package glib_test
+
+import (
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+func TestSubprocessCommunicateUtf8(t *testing.T) {
+	echo := func() *glib.Subprocess {
+		process, err := glib.SubprocessNew([]string{"/bin/echo", "hello"},
+			glib.SUBPROCESS_FLAGS_STDOUT_PIPE)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return process
+	}
+
+	stdout, _, err := echo().CommunicateUtf8("", nil)
+	if err != nil || stdout != "hello\n" {
+		t.Errorf("Expected %q, got %q (%v)", "hello\n", stdout, err)
+	}
+
+	process := echo()
+	callback, ch := glib.AsyncResultChan()
+	process.CommunicateUtf8Async("", nil, callback)
+	stdout, _, err = process.CommunicateUtf8Finish(waitResult(t, ch))
+	if err != nil || stdout != "hello\n" {
+		t.Errorf("Expected %q, got %q (%v)", "hello\n", stdout, err)
+	}
+	if !process.GetSuccessful() {
+		t.Error("Expected the process to exit successfully")
+	}
+
+	// Input requires a stdin pipe, which echo was not given.
+	if _, _, err := echo().CommunicateUtf8("input", nil); err == nil {
+		t.Error("Expected an error writing to a process without stdin pipe")
+	}
+	process = echo()
+	callback, ch = glib.AsyncResultChan()
+	process.CommunicateUtf8Async("input", nil, callback)
+	if _, _, err := process.CommunicateUtf8Finish(waitResult(t, ch)); err == nil {
+		t.Error("Expected an error writing to a process without stdin pipe")
+	}
+}
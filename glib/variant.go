@@ -70,6 +70,17 @@ func newVariantType(v *C.GVariantType) *VariantType {
 	return &VariantType{v}
 }
 
+// copyVariantType returns a copy of a GVariantType which belongs to
+// another structure, so that it outlives it. The copy is freed once
+// it is garbage collected.
+func copyVariantType(c *C.GVariantType) *VariantType {
+	v := &VariantType{C.g_variant_type_copy(c)}
+	runtime.SetFinalizer(v, func(v *VariantType) {
+		C.g_variant_type_free(v.gvariantType)
+	})
+	return v
+}
+
 // Variant types for comparing between them.  Cannot be const because
 // they are pointers.
 var (
@@ -183,6 +194,14 @@ func WrapVariant(ptr unsafe.Pointer) *Variant {
 	return vr
 }
 
+// wrapVariantFull wraps a full reference to a GVariant, which is
+// released once the Variant is garbage collected.
+func wrapVariantFull(p *C.GVariant) *Variant {
+	vr := newVariant(p)
+	runtime.SetFinalizer(vr, (*Variant).Unref)
+	return vr
+}
+
 // newVariant creates a new Variant from a GVariant pointer.
 func newVariant(p *C.GVariant) *Variant {
 	return &Variant{p}
@@ -720,6 +720,21 @@ func (v *Widget) GetPreferredWidth() (int, int) {
 	return int(minimum), int(natural)
 }
 
+// InsertActionGroup is a wrapper around gtk_widget_insert_action_group().
+// The actions of group are available to the widget and its descendants
+// under name, like "row.delete" for the "delete" action of a group
+// inserted as "row". A nil group removes the group inserted as name.
+func (v *Widget) InsertActionGroup(name string, group glib.IActionGroup) {
+	cstr := C.CString(name)
+	defer C.free(unsafe.Pointer(cstr))
+
+	var cgroup *C.GActionGroup
+	if group != nil {
+		cgroup = C.toGActionGroup(unsafe.Pointer(group.Native()))
+	}
+	C.gtk_widget_insert_action_group(v.native(), (*C.gchar)(cstr), cgroup)
+}
+
 /*
  * GtkContainer
  */
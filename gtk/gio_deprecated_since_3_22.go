@@ -9,10 +9,10 @@ package gtk
 // #include "gtk.go.h"
 import "C"
 import (
-	"errors"
 	"unsafe"
 
 	"github.com/romychs/gotk3/gdk"
+	"github.com/romychs/gotk3/glib"
 )
 
 // ShowUri is a wrapper for gtk_show_uri().
@@ -25,8 +25,7 @@ func ShowUri(screen *gdk.Screen, uri string) error {
 	c := C.gtk_show_uri(C.toGdkScreen(unsafe.Pointer(screen.Native())),
 		cstr, C.gtk_get_current_event_time(), &err)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
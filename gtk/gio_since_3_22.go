@@ -9,8 +9,9 @@ package gtk
 // #include <gtk/gtk.h>
 import "C"
 import (
-	"errors"
 	"unsafe"
+
+	"github.com/romychs/gotk3/glib"
 )
 
 // ShowUriOnWindow is a wrapper for gtk_show_uri_on_window().
@@ -22,8 +23,7 @@ func ShowUriOnWindow(parent *Window, uri string) error {
 
 	c := C.gtk_show_uri_on_window(parent.native(), cstr, C.gtk_get_current_event_time(), &err)
 	if !gobool(c) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -890,6 +890,53 @@ func Init(args *[]string) {
 	}
 }
 
+// GetOptionGroup is a wrapper around gtk_get_option_group(). The returned
+// group is meant to be added to a glib.OptionContext, which takes
+// ownership of it, so that GTK+ options are parsed along with the
+// application ones. GTK+ is initialized once the context is parsed.
+func GetOptionGroup(openDefaultDisplay bool) *glib.OptionGroup {
+	c := C.gtk_get_option_group(gbool(openDefaultDisplay))
+	return glib.WrapOptionGroup(uintptr(unsafe.Pointer(c)))
+}
+
+/*
+InitWithArgs() does the same work as gtk_init_with_args(): it initializes
+GTK+ while parsing standard GTK+ command line arguments together with
+entries, bound to Go variables as described by glib.OptionEntry.
+
+args must include the program name as the first element, like os.Args
+does. The arguments which were not handled are returned, starting with
+the program name. A non-nil error is returned if the arguments can not
+be parsed, or if the default display can not be opened.
+*/
+func InitWithArgs(args []string, parameterString string,
+	entries []glib.OptionEntry, translationDomain string) ([]string, error) {
+
+	context, err := glib.OptionContextNew(parameterString)
+	if err != nil {
+		return nil, err
+	}
+	if translationDomain != "" {
+		context.SetTranslationDomain(translationDomain)
+	}
+	if len(entries) > 0 {
+		err = context.AddMainEntries(entries, translationDomain)
+		if err != nil {
+			return nil, err
+		}
+	}
+	context.AddGroup(GetOptionGroup(true))
+
+	unhandled, err := context.Parse(args)
+	if err != nil {
+		return nil, err
+	}
+	if C.gdk_display_get_default() == nil {
+		return unhandled, errors.New("cannot open display")
+	}
+	return unhandled, nil
+}
+
 // Main is a wrapper around gtk_main() and runs the GTK main loop,
 // blocking until MainQuit() is called.
 func Main() {
@@ -1049,6 +1096,40 @@ void	gtk_adjustment_configure ()
  * GtkBuilder
  */
 
+// BuilderErrorQuark is a wrapper around gtk_builder_error_quark().
+func BuilderErrorQuark() glib.Quark {
+	return glib.Quark(C.gtk_builder_error_quark())
+}
+
+// BuilderError is a representation of GTK's GtkBuilderError, the codes of
+// the errors of the BuilderErrorQuark domain.
+type BuilderError int
+
+const (
+	BUILDER_ERROR_INVALID_TYPE_FUNCTION  BuilderError = C.GTK_BUILDER_ERROR_INVALID_TYPE_FUNCTION
+	BUILDER_ERROR_UNHANDLED_TAG          BuilderError = C.GTK_BUILDER_ERROR_UNHANDLED_TAG
+	BUILDER_ERROR_MISSING_ATTRIBUTE      BuilderError = C.GTK_BUILDER_ERROR_MISSING_ATTRIBUTE
+	BUILDER_ERROR_INVALID_ATTRIBUTE      BuilderError = C.GTK_BUILDER_ERROR_INVALID_ATTRIBUTE
+	BUILDER_ERROR_INVALID_TAG            BuilderError = C.GTK_BUILDER_ERROR_INVALID_TAG
+	BUILDER_ERROR_MISSING_PROPERTY_VALUE BuilderError = C.GTK_BUILDER_ERROR_MISSING_PROPERTY_VALUE
+	BUILDER_ERROR_INVALID_VALUE          BuilderError = C.GTK_BUILDER_ERROR_INVALID_VALUE
+	BUILDER_ERROR_VERSION_MISMATCH       BuilderError = C.GTK_BUILDER_ERROR_VERSION_MISMATCH
+	BUILDER_ERROR_DUPLICATE_ID           BuilderError = C.GTK_BUILDER_ERROR_DUPLICATE_ID
+)
+
+// Errors of the BuilderErrorQuark domain, to be matched with errors.Is.
+var (
+	ErrBuilderInvalidTypeFunction  = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_INVALID_TYPE_FUNCTION), "invalid type function")
+	ErrBuilderUnhandledTag         = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_UNHANDLED_TAG), "unhandled tag")
+	ErrBuilderMissingAttribute     = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_MISSING_ATTRIBUTE), "missing attribute")
+	ErrBuilderInvalidAttribute     = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_INVALID_ATTRIBUTE), "invalid attribute")
+	ErrBuilderInvalidTag           = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_INVALID_TAG), "invalid tag")
+	ErrBuilderMissingPropertyValue = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_MISSING_PROPERTY_VALUE), "missing property value")
+	ErrBuilderInvalidValue         = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_INVALID_VALUE), "invalid value")
+	ErrBuilderVersionMismatch      = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_VERSION_MISMATCH), "version mismatch")
+	ErrBuilderDuplicateID          = glib.NewError(BuilderErrorQuark(), int(BUILDER_ERROR_DUPLICATE_ID), "duplicate id")
+)
+
 // Builder is a representation of GTK's GtkBuilder.
 type Builder struct {
 	*glib.Object
@@ -1104,8 +1185,7 @@ func (v *Builder) AddFromFile(filename string) error {
 	var err *C.GError
 	res := C.gtk_builder_add_from_file(v.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1117,8 +1197,7 @@ func (v *Builder) AddFromResource(path string) error {
 	var err *C.GError
 	res := C.gtk_builder_add_from_resource(v.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1131,12 +1210,32 @@ func (v *Builder) AddFromString(str string) error {
 	var err *C.GError
 	res := C.gtk_builder_add_from_string(v.native(), (*C.gchar)(cstr), length, &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
 
+// SetTranslationDomain is a wrapper around gtk_builder_set_translation_domain().
+// The translatable strings of the .ui files added afterwards are looked up
+// in domain, or in the default domain set by glib.TextDomain if empty.
+func (v *Builder) SetTranslationDomain(domain string) {
+	var cstr *C.gchar
+	if domain != "" {
+		cstr = (*C.gchar)(C.CString(domain))
+		defer C.free(unsafe.Pointer(cstr))
+	}
+	C.gtk_builder_set_translation_domain(v.native(), cstr)
+}
+
+// GetTranslationDomain is a wrapper around gtk_builder_get_translation_domain().
+func (v *Builder) GetTranslationDomain() string {
+	c := C.gtk_builder_get_translation_domain(v.native())
+	if c == nil {
+		return ""
+	}
+	return goString(c)
+}
+
 // GetObject is a wrapper around gtk_builder_get_object(). The returned result
 // is an IObject, so it will need to be type-asserted to the appropriate type before
 // being used. For example, to get an object and type assert it as a window:
@@ -1426,6 +1525,34 @@ func (v *Clipboard) WaitForContents(target gdk.Atom) (*SelectionData, error) {
  * GtkCssProvider
  */
 
+// CssProviderErrorQuark is a wrapper around gtk_css_provider_error_quark().
+func CssProviderErrorQuark() glib.Quark {
+	return glib.Quark(C.gtk_css_provider_error_quark())
+}
+
+// CssProviderError is a representation of GTK's GtkCssProviderError, the
+// codes of the errors of the CssProviderErrorQuark domain.
+type CssProviderError int
+
+const (
+	CSS_PROVIDER_ERROR_FAILED        CssProviderError = C.GTK_CSS_PROVIDER_ERROR_FAILED
+	CSS_PROVIDER_ERROR_SYNTAX        CssProviderError = C.GTK_CSS_PROVIDER_ERROR_SYNTAX
+	CSS_PROVIDER_ERROR_IMPORT        CssProviderError = C.GTK_CSS_PROVIDER_ERROR_IMPORT
+	CSS_PROVIDER_ERROR_NAME          CssProviderError = C.GTK_CSS_PROVIDER_ERROR_NAME
+	CSS_PROVIDER_ERROR_DEPRECATED    CssProviderError = C.GTK_CSS_PROVIDER_ERROR_DEPRECATED
+	CSS_PROVIDER_ERROR_UNKNOWN_VALUE CssProviderError = C.GTK_CSS_PROVIDER_ERROR_UNKNOWN_VALUE
+)
+
+// Errors of the CssProviderErrorQuark domain, to be matched with errors.Is.
+var (
+	ErrCssProviderFailed       = glib.NewError(CssProviderErrorQuark(), int(CSS_PROVIDER_ERROR_FAILED), "failed")
+	ErrCssProviderSyntax       = glib.NewError(CssProviderErrorQuark(), int(CSS_PROVIDER_ERROR_SYNTAX), "syntax error")
+	ErrCssProviderImport       = glib.NewError(CssProviderErrorQuark(), int(CSS_PROVIDER_ERROR_IMPORT), "import failed")
+	ErrCssProviderName         = glib.NewError(CssProviderErrorQuark(), int(CSS_PROVIDER_ERROR_NAME), "unknown name")
+	ErrCssProviderDeprecated   = glib.NewError(CssProviderErrorQuark(), int(CSS_PROVIDER_ERROR_DEPRECATED), "deprecated")
+	ErrCssProviderUnknownValue = glib.NewError(CssProviderErrorQuark(), int(CSS_PROVIDER_ERROR_UNKNOWN_VALUE), "unknown value")
+)
+
 // CssProvider is a representation of GTK's GtkCssProvider.
 type CssProvider struct {
 	*glib.Object
@@ -1474,8 +1601,7 @@ func (v *CssProvider) LoadFromPath(path string) error {
 	defer C.free(unsafe.Pointer(cpath))
 	var gerr *C.GError
 	if C.gtk_css_provider_load_from_path(v.native(), (*C.gchar)(cpath), &gerr) == 0 {
-		defer C.g_error_free(gerr)
-		return errors.New(goString(gerr.message))
+		return glib.TakeError(unsafe.Pointer(gerr))
 	}
 	return nil
 }
@@ -1486,8 +1612,7 @@ func (v *CssProvider) LoadFromData(data string) error {
 	defer C.free(unsafe.Pointer(cdata))
 	var gerr *C.GError
 	if C.gtk_css_provider_load_from_data(v.native(), (*C.gchar)(unsafe.Pointer(cdata)), C.gssize(len(data)), &gerr) == 0 {
-		defer C.g_error_free(gerr)
-		return errors.New(goString(gerr.message))
+		return glib.TakeError(unsafe.Pointer(gerr))
 	}
 	return nil
 }
@@ -1770,8 +1895,7 @@ func (v *IconTheme) LoadIcon(iconName string, size int,
 	c := C.gtk_icon_theme_load_icon(v.native(), (*C.gchar)(cstr),
 		C.gint(size), C.GtkIconLookupFlags(flags), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	return &gdk.Pixbuf{glib.Take(unsafe.Pointer(c))}, nil
 }
@@ -783,6 +783,12 @@ toGMenuModel(void *p)
 	return (G_MENU_MODEL(p));
 }
 
+static GActionGroup *
+toGActionGroup(void *p)
+{
+	return (G_ACTION_GROUP(p));
+}
+
 static GFile *
 toGFile(void *p)
 {
@@ -122,3 +122,31 @@ func (v *StackSidebar) GetStack() *Stack {
 	obj := glib.Take(unsafe.Pointer(c))
 	return wrapStack(obj)
 }
+
+// GetActionGroup is a wrapper around gtk_widget_get_action_group(). It
+// returns the group inserted as prefix into the widget or its
+// ancestors, or nil.
+func (v *Widget) GetActionGroup(prefix string) *glib.ActionGroup {
+	cstr := C.CString(prefix)
+	defer C.free(unsafe.Pointer(cstr))
+
+	c := C.gtk_widget_get_action_group(v.native(), (*C.gchar)(cstr))
+	if c == nil {
+		return nil
+	}
+	obj := glib.Take(unsafe.Pointer(c))
+	return glib.ToActionGroup(obj)
+}
+
+// ListActionPrefixes is a wrapper around gtk_widget_list_action_prefixes().
+// It returns the prefixes of the groups available to the widget.
+func (v *Widget) ListActionPrefixes() []string {
+	c := C.gtk_widget_list_action_prefixes(v.native())
+	if c == nil {
+		return nil
+	}
+	// The array is owned by the caller, but not the strings.
+	defer C.g_free(C.gpointer(c))
+
+	return goStringArray(c)
+}
@@ -21,3 +21,6 @@ toGtkStackSidebar(void *p)
 {
 	return (GTK_STACK_SIDEBAR(p));
 }
+
+extern GtkWidget *goGtkCreateWidget(gpointer item, gpointer user_data);
+extern void goGtkCreateWidgetDestroy(gpointer user_data);
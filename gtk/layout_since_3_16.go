@@ -0,0 +1,86 @@
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14
+
+// See: https://developer.gnome.org/gtk3/3.16/api-index-3-16.html
+
+package gtk
+
+// #cgo pkg-config: gtk+-3.0
+// #include <gtk/gtk.h>
+// #include "gtk.go.h"
+// #include "gtk_since_3_16.go.h"
+import "C"
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+// GtkListBoxCreateWidgetCallback returns the widget representing item,
+// an item of the model bound with ListBox.BindModel. It must not
+// return nil.
+type GtkListBoxCreateWidgetCallback func(item *glib.Object) IWidget
+
+var (
+	gtkCreateWidgetCallbackRegistry = struct {
+		sync.RWMutex
+		next int
+		m    map[int]func(item *glib.Object) IWidget
+	}{
+		next: 1,
+		m:    make(map[int]func(item *glib.Object) IWidget),
+	}
+)
+
+// registerCreateWidgetCallback stores callback until the model is
+// unbound and returns the user data to pass to goGtkCreateWidget.
+func registerCreateWidgetCallback(callback func(item *glib.Object) IWidget) C.gpointer {
+	gtkCreateWidgetCallbackRegistry.Lock()
+	id := gtkCreateWidgetCallbackRegistry.next
+	gtkCreateWidgetCallbackRegistry.next++
+	gtkCreateWidgetCallbackRegistry.m[id] = callback
+	gtkCreateWidgetCallbackRegistry.Unlock()
+
+	return C.gpointer(uintptr(id))
+}
+
+//export goGtkCreateWidget
+func goGtkCreateWidget(item C.gpointer, userData C.gpointer) *C.GtkWidget {
+	id := int(uintptr(userData))
+
+	gtkCreateWidgetCallbackRegistry.RLock()
+	fn := gtkCreateWidgetCallbackRegistry.m[id]
+	gtkCreateWidgetCallbackRegistry.RUnlock()
+
+	widget := fn(glib.Take(unsafe.Pointer(item))).toWidget()
+	// The box expects a full reference, while the Go
+	// wrapper keeps its own one.
+	C.g_object_ref(C.gpointer(widget))
+	return widget
+}
+
+//export goGtkCreateWidgetDestroy
+func goGtkCreateWidgetDestroy(userData C.gpointer) {
+	id := int(uintptr(userData))
+
+	gtkCreateWidgetCallbackRegistry.Lock()
+	delete(gtkCreateWidgetCallbackRegistry.m, id)
+	gtkCreateWidgetCallbackRegistry.Unlock()
+}
+
+// BindModel is a wrapper around gtk_list_box_bind_model().
+// The rows of the box are created by createWidget from the items of
+// model, and follow the changes of model. While a model is bound, rows
+// must not be added or removed directly. A nil model unbinds the box.
+func (v *ListBox) BindModel(model glib.IListModel, createWidget GtkListBoxCreateWidgetCallback) {
+	if model == nil {
+		C.gtk_list_box_bind_model(v.native(), nil, nil, nil, nil)
+		return
+	}
+
+	C.gtk_list_box_bind_model(v.native(),
+		(*C.GListModel)(unsafe.Pointer(model.Native())),
+		C.GtkListBoxCreateWidgetFunc(C.goGtkCreateWidget),
+		registerCreateWidgetCallback(createWidget),
+		C.GDestroyNotify(C.goGtkCreateWidgetDestroy))
+}
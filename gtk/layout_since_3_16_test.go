@@ -0,0 +1,102 @@
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14
+
+package gtk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/romychs/gotk3/glib"
+)
+
+// newActionStore returns a list store of actions with the given names.
+func newActionStore(t *testing.T, names ...string) *glib.ListStore {
+	t.Helper()
+	store, err := glib.ListStoreNew(glib.TYPE_OBJECT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names {
+		appendAction(t, store, name)
+	}
+	return store
+}
+
+func appendAction(t *testing.T, store *glib.ListStore, name string) {
+	t.Helper()
+	action, err := glib.SimpleActionNew(name, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.Append(action)
+}
+
+// actionLabel creates a label showing the name of the action item, and
+// records the name in created.
+func actionLabel(created *[]string) func(item *glib.Object) IWidget {
+	return func(item *glib.Object) IWidget {
+		name, _ := item.GetProperty("name")
+		s, _ := name.(string)
+		*created = append(*created, s)
+		label, err := LabelNew(s)
+		if err != nil {
+			panic(err)
+		}
+		return label
+	}
+}
+
+// binLabelText returns the text of the label child of bin.
+func binLabelText(bin *Bin) string {
+	child, err := bin.GetChild()
+	if err != nil {
+		return ""
+	}
+	text, _ := wrapLabel(child.Object).GetText()
+	return text
+}
+
+func createWidgetCallbacks() int {
+	gtkCreateWidgetCallbackRegistry.RLock()
+	defer gtkCreateWidgetCallbackRegistry.RUnlock()
+	return len(gtkCreateWidgetCallbackRegistry.m)
+}
+
+func TestListBox_BindModel(t *testing.T) {
+	box, err := ListBoxNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := newActionStore(t, "copy", "cut")
+	callbacks := createWidgetCallbacks()
+
+	var created []string
+	box.BindModel(store, actionLabel(&created))
+	appendAction(t, store, "paste")
+	store.Remove(0)
+
+	if want := []string{"copy", "cut", "paste"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("Expected rows to be created for %v, got %v", want, created)
+	}
+	for i, want := range []string{"cut", "paste"} {
+		row := box.GetRowAtIndex(i)
+		if row == nil {
+			t.Fatalf("Expected a row at index %d", i)
+		}
+		if text := binLabelText(&row.Bin); text != want {
+			t.Errorf("Expected row %d to show %q, got %q", i, want, text)
+		}
+	}
+	if box.GetRowAtIndex(2) != nil {
+		t.Error("Expected the removed item to remove its row")
+	}
+
+	// Unbinding empties the box and releases the callback.
+	box.BindModel(nil, nil)
+	if box.GetRowAtIndex(0) != nil {
+		t.Error("Expected no rows once the model is unbound")
+	}
+	if n := createWidgetCallbacks(); n != callbacks {
+		t.Errorf("Expected the callback to be released, got %d callbacks instead of %d", n, callbacks)
+	}
+}
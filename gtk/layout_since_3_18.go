@@ -21,7 +21,13 @@ package gtk
 
 // #include <gtk/gtk.h>
 // #include "gtk.go.h"
+// #include "gtk_since_3_16.go.h"
 import "C"
+import (
+	"unsafe"
+
+	"github.com/romychs/gotk3/glib"
+)
 
 // ReorderOverlay is a wrapper around gtk_overlay_reorder_overlay().
 func (v *Overlay) ReorderOverlay(child IWidget, position int) {
@@ -40,3 +46,26 @@ func (v *Overlay) GetOverlayPassThrough(widget IWidget) bool {
 func (v *Overlay) SetOverlayPassThrough(widget IWidget, passThrough bool) {
 	C.gtk_overlay_set_overlay_pass_through(v.native(), widget.toWidget(), gbool(passThrough))
 }
+
+// GtkFlowBoxCreateWidgetCallback returns the widget representing item,
+// an item of the model bound with FlowBox.BindModel. It must not
+// return nil.
+type GtkFlowBoxCreateWidgetCallback func(item *glib.Object) IWidget
+
+// BindModel is a wrapper around gtk_flow_box_bind_model().
+// The children of the box are created by createWidget from the items of
+// model, and follow the changes of model. While a model is bound,
+// children must not be added or removed directly. A nil model unbinds
+// the box.
+func (v *FlowBox) BindModel(model glib.IListModel, createWidget GtkFlowBoxCreateWidgetCallback) {
+	if model == nil {
+		C.gtk_flow_box_bind_model(v.native(), nil, nil, nil, nil)
+		return
+	}
+
+	C.gtk_flow_box_bind_model(v.native(),
+		(*C.GListModel)(unsafe.Pointer(model.Native())),
+		C.GtkFlowBoxCreateWidgetFunc(C.goGtkCreateWidget),
+		registerCreateWidgetCallback(createWidget),
+		C.GDestroyNotify(C.goGtkCreateWidgetDestroy))
+}
@@ -0,0 +1,42 @@
+// +build !gtk_3_6,!gtk_3_8,!gtk_3_10,!gtk_3_12,!gtk_3_14,!gtk_3_16
+
+package gtk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlowBox_BindModel(t *testing.T) {
+	box, err := FlowBoxNew()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := newActionStore(t, "copy", "cut")
+	callbacks := createWidgetCallbacks()
+
+	var created []string
+	box.BindModel(store, actionLabel(&created))
+	appendAction(t, store, "paste")
+
+	if want := []string{"copy", "cut", "paste"}; !reflect.DeepEqual(created, want) {
+		t.Errorf("Expected children to be created for %v, got %v", want, created)
+	}
+	for i, want := range []string{"copy", "cut", "paste"} {
+		child := box.GetChildAtIndex(i)
+		if child == nil {
+			t.Fatalf("Expected a child at index %d", i)
+		}
+		if text := binLabelText(&child.Bin); text != want {
+			t.Errorf("Expected child %d to show %q, got %q", i, want, text)
+		}
+	}
+
+	box.BindModel(nil, nil)
+	if box.GetChildAtIndex(0) != nil {
+		t.Error("Expected no children once the model is unbound")
+	}
+	if n := createWidgetCallbacks(); n != callbacks {
+		t.Errorf("Expected the callback to be released, got %d callbacks instead of %d", n, callbacks)
+	}
+}
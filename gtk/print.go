@@ -375,8 +375,7 @@ func PageSetupNewFromFile(fileName string) (*PageSetup, error) {
 	var err *C.GError = nil
 	c := C.gtk_page_setup_new_from_file((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	obj := glib.Take(unsafe.Pointer(c))
 	return &PageSetup{obj}, nil
@@ -392,8 +391,7 @@ func (v *PageSetup) PageSetupLoadFile(name string) error {
 	var err *C.GError = nil
 	res := C.gtk_page_setup_load_file(v.native(), cstr, &err)
 	if !gobool(res) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -407,8 +405,7 @@ func (v *PageSetup) PageSetupToFile(name string) error {
 	var err *C.GError = nil
 	res := C.gtk_page_setup_to_file(v.native(), cstr, &err)
 	if !gobool(res) {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -786,8 +783,7 @@ func (v *PrintOperation) PrintOperationSetAllowAsync(allowSync bool) {
 func (v *PrintOperation) PrintOperationGetError() error {
 	var err *C.GError
 	C.gtk_print_operation_get_error(v.native(), &err)
-	defer C.g_error_free(err)
-	return errors.New(goString(err.message))
+	return glib.TakeError(unsafe.Pointer(err))
 }
 
 // SetDefaultPageSetup is a wrapper around gtk_print_operation_set_default_page_setup().
@@ -883,8 +879,7 @@ func (v *PrintOperation) Run(action PrintOperationAction, parent *Window) (Print
 	c := C.gtk_print_operation_run(v.native(), C.GtkPrintOperationAction(action), parent.native(), &err)
 	res := PrintOperationResult(c)
 	if res == PRINT_OPERATION_RESULT_ERROR {
-		defer C.g_error_free(err)
-		return res, errors.New(goString(err.message))
+		return res, glib.TakeError(unsafe.Pointer(err))
 	}
 	return res, nil
 }
@@ -1565,8 +1560,7 @@ func PrintSettingsNewFromFile(name string) (*PrintSettings, error) {
 	var err *C.GError
 	c := C.gtk_print_settings_new_from_file((*C.gchar)(cstr), &err)
 	if c == nil {
-		defer C.g_error_free(err)
-		return nil, errors.New(goString(err.message))
+		return nil, glib.TakeError(unsafe.Pointer(err))
 	}
 	obj := glib.Take(unsafe.Pointer(c))
 	return wrapPrintSettings(obj), nil
@@ -1581,8 +1575,7 @@ func (v *PrintSettings) LoadFile(name string) error {
 	var err *C.GError
 	c := C.gtk_print_settings_load_file(v.native(), (*C.gchar)(cstr), &err)
 	if gobool(c) == false {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1596,7 +1589,7 @@ func (v *PrintSettings) ToFile(name string) error {
 	var err *C.GError
 	c := C.gtk_print_settings_to_file(v.native(), (*C.gchar)(cstr), &err)
 	if gobool(c) == false {
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
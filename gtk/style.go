@@ -281,8 +281,7 @@ func (v *CssProvider) LoadFromData(data string) error {
 
 	C.gtk_css_provider_load_from_data(v.native(), cstr, -1, &err)
 	if err != nil {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 
 	return nil
@@ -7,7 +7,6 @@ package gtk
 // #include "gtk.go.h"
 import "C"
 import (
-	"errors"
 	"fmt"
 	"unsafe"
 
@@ -501,8 +500,7 @@ func WindowSetDefaultIconFromFile(file string) error {
 	var err *C.GError
 	res := C.gtk_window_set_default_icon_from_file((*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -521,8 +519,7 @@ func (v *Window) SetIconFromFile(file string) error {
 	var err *C.GError
 	res := C.gtk_window_set_icon_from_file(v.native(), (*C.gchar)(cstr), &err)
 	if res == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -1330,8 +1327,18 @@ func (v *AppChooser) toAppChooser() *C.GtkAppChooser {
 	return v.native()
 }
 
-// TODO: Needs gio/GAppInfo implementation first
-// gtk_app_chooser_get_app_info ()
+// GetAppInfo is a wrapper around gtk_app_chooser_get_app_info().
+// nil is returned if no application is selected.
+func (v *AppChooser) GetAppInfo() *glib.AppInfo {
+	c := C.gtk_app_chooser_get_app_info(v.native())
+	if c == nil {
+		return nil
+	}
+	// The info is returned with a full reference,
+	// which is released once it is wrapped.
+	defer C.g_object_unref(C.gpointer(c))
+	return glib.WrapAppInfo(uintptr(unsafe.Pointer(c)))
+}
 
 // GetContentType is a wrapper around gtk_app_chooser_get_content_type().
 func (v *AppChooser) GetContentType() string {
@@ -123,8 +123,7 @@ func (v *NotifyNotification) Show() error {
 	var err *C.GError
 	c := C.notify_notification_show(v.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }
@@ -168,8 +167,7 @@ func (v *NotifyNotification) Close() error {
 	var err *C.GError
 	c := C.notify_notification_close(v.native(), &err)
 	if c == 0 {
-		defer C.g_error_free(err)
-		return errors.New(goString(err.message))
+		return glib.TakeError(unsafe.Pointer(err))
 	}
 	return nil
 }